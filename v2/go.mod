@@ -3,16 +3,15 @@ module github.com/Sagleft/utopialib-go/v2
 go 1.18
 
 require (
-	github.com/Sagleft/evtwebsocket v1.0.1
 	github.com/beefsack/go-rate v0.0.0-20220214233405-116f4ca011a0
 	github.com/golang/mock v1.6.0
 	github.com/stretchr/testify v1.8.1
+	golang.org/x/net v0.18.0
 	gopkg.in/grignaak/tribool.v1 v1.0.0-20150312065122-d6bb19d816df
 )
 
 require (
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/pmezard/go-difflib v1.0.0 // indirect
-	golang.org/x/net v0.18.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
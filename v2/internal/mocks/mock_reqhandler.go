@@ -5,6 +5,7 @@
 package bots
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
@@ -34,16 +35,16 @@ func (m *MockRequestHandler) EXPECT() *MockRequestHandlerMockRecorder {
 }
 
 // Send mocks base method.
-func (m *MockRequestHandler) Send(reqType, URL string, data []byte) ([]byte, error) {
+func (m *MockRequestHandler) Send(ctx context.Context, reqType, URL string, data []byte) ([]byte, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Send", reqType, URL, data)
+	ret := m.ctrl.Call(m, "Send", ctx, reqType, URL, data)
 	ret0, _ := ret[0].([]byte)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Send indicates an expected call of Send.
-func (mr *MockRequestHandlerMockRecorder) Send(reqType, URL, data interface{}) *gomock.Call {
+func (mr *MockRequestHandlerMockRecorder) Send(ctx, reqType, URL, data interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockRequestHandler)(nil).Send), reqType, URL, data)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockRequestHandler)(nil).Send), ctx, reqType, URL, data)
 }
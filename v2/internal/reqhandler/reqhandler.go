@@ -2,6 +2,7 @@ package reqhandler
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"net/http"
@@ -9,7 +10,7 @@ import (
 )
 
 type RequestHandler interface {
-	Send(reqType, URL string, data []byte) ([]byte, error)
+	Send(ctx context.Context, reqType, URL string, data []byte) ([]byte, error)
 }
 
 type defaultHandler struct {
@@ -22,9 +23,13 @@ func NewDefaultHandler(timeout time.Duration) RequestHandler {
 	}
 }
 
-func (h *defaultHandler) Send(reqType, URL string, data []byte) ([]byte, error) {
+func (h *defaultHandler) Send(
+	ctx context.Context,
+	reqType, URL string,
+	data []byte,
+) ([]byte, error) {
 
-	req, err := http.NewRequest(reqType, URL, bytes.NewBuffer(data))
+	req, err := http.NewRequestWithContext(ctx, reqType, URL, bytes.NewBuffer(data))
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -0,0 +1,133 @@
+package utopia
+
+import (
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	uerrors "github.com/Sagleft/utopialib-go/v2/pkg/errors"
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+// avatarCacheKeyRegexp - cache keys are used as file names
+var avatarCacheKeyRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+// GetContactAvatar - download contact avatar.
+// avatarHash is optional (ContactData.AvatarHash) and is used as the cache key
+func (c *UtopiaClient) GetContactAvatar(pubkey, avatarHash string) (structs.Avatar, error) {
+	if pubkey == "" {
+		return structs.Avatar{}, ErrorPubkeyUnset
+	}
+
+	return c.getAvatar(reqGetContactAvatar, uMap{"pk": pubkey}, avatarHash)
+}
+
+// GetChannelAvatar - download channel avatar.
+// avatarID is optional (SearchChannelData.AvatarID) and is used as the cache key
+func (c *UtopiaClient) GetChannelAvatar(channelID, avatarID string) (structs.Avatar, error) {
+	if channelID == "" {
+		return structs.Avatar{}, ErrorChannelIDUnset
+	}
+
+	return c.getAvatar(reqGetChannelAvatar, uMap{"channelid": channelID}, avatarID)
+}
+
+// GetOwnAvatar - download own account avatar
+func (c *UtopiaClient) GetOwnAvatar() (structs.Avatar, error) {
+	contact, err := c.GetOwnContact()
+	if err != nil {
+		return structs.Avatar{}, err
+	}
+
+	return c.GetContactAvatar(contact.Pubkey, contact.AvatarHash)
+}
+
+// SetProfileAvatar - update own account avatar. PNG or JPG image expected
+func (c *UtopiaClient) SetProfileAvatar(image io.Reader) error {
+	data, err := io.ReadAll(image)
+	if err != nil {
+		return fmt.Errorf("failed to read image: %w", err)
+	}
+
+	format := structs.DetectImageFormat(data)
+	if format == consts.ImageFormatUnknown {
+		return ErrorImageFormat
+	}
+
+	_, err = c.queryResultToString(reqSetProfileAvatar, uMap{
+		"base64_image": base64.StdEncoding.EncodeToString(data),
+		"format":       string(format),
+	})
+	return err
+}
+
+func (c *UtopiaClient) getAvatar(method string, params uMap, cacheKey string) (
+	structs.Avatar,
+	error,
+) {
+	if data, isFound := c.loadCachedAvatar(cacheKey); isFound {
+		return structs.Avatar{
+			Data:   data,
+			Format: structs.DetectImageFormat(data),
+		}, nil
+	}
+
+	params["coder"] = "BASE64"
+	params["format"] = string(consts.ImageFormatPNG)
+	result, err := c.queryResultToString(method, params)
+	if err != nil {
+		return structs.Avatar{}, err
+	}
+
+	data, err := base64.StdEncoding.DecodeString(result)
+	if err != nil {
+		return structs.Avatar{}, uerrors.NewDecodeError(
+			method,
+			fmt.Errorf("failed to decode avatar: %w", err),
+		)
+	}
+
+	c.saveCachedAvatar(cacheKey, data)
+	return structs.Avatar{
+		Data:   data,
+		Format: structs.DetectImageFormat(data),
+	}, nil
+}
+
+func (c *UtopiaClient) getAvatarCachePath(cacheKey string) (string, bool) {
+	if c.data.AvatarsCacheDir == "" || !avatarCacheKeyRegexp.MatchString(cacheKey) {
+		return "", false
+	}
+	return filepath.Join(c.data.AvatarsCacheDir, cacheKey), true
+}
+
+func (c *UtopiaClient) loadCachedAvatar(cacheKey string) ([]byte, bool) {
+	filePath, isCacheable := c.getAvatarCachePath(cacheKey)
+	if !isCacheable {
+		return nil, false
+	}
+
+	data, err := os.ReadFile(filePath)
+	if err != nil || len(data) == 0 {
+		return nil, false
+	}
+	return data, true
+}
+
+// saveCachedAvatar stores avatar in the cache dir. cache errors are ignored:
+// avatar is just downloaded again next time
+func (c *UtopiaClient) saveCachedAvatar(cacheKey string, data []byte) {
+	filePath, isCacheable := c.getAvatarCachePath(cacheKey)
+	if !isCacheable || len(data) == 0 {
+		return
+	}
+
+	if err := os.MkdirAll(c.data.AvatarsCacheDir, 0o755); err != nil {
+		return
+	}
+	_ = os.WriteFile(filePath, data, 0o644)
+}
@@ -0,0 +1,95 @@
+package utopia
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+)
+
+var testPNGImage = []byte("\x89PNG\r\n\x1a\ntest image")
+
+func getTestAvatarResponse() []byte {
+	return []byte(fmt.Sprintf(
+		`{"result": %q}`,
+		base64.StdEncoding.EncodeToString(testPNGImage),
+	))
+}
+
+func TestGetContactAvatar(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when pubkey is not set
+	_, err := c.GetContactAvatar("", "")
+	require.ErrorIs(t, err, ErrorPubkeyUnset)
+
+	// when everything is ok
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(getTestAvatarResponse(), nil)
+
+	avatar, err := c.GetContactAvatar("pubkey", "")
+	require.NoError(t, err)
+	assert.Equal(t, testPNGImage, avatar.Data)
+	assert.Equal(t, consts.ImageFormatPNG, avatar.Format)
+
+	// when avatar is not base64 encoded
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": "%%%"}`), nil)
+
+	_, err = c.GetContactAvatar("pubkey", "")
+	require.Error(t, err)
+}
+
+func TestGetAvatarCache(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+	c.data.AvatarsCacheDir = t.TempDir()
+
+	// avatar is requested only once
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).Return(getTestAvatarResponse(), nil)
+
+	for i := 0; i < 2; i++ {
+		avatar, err := c.GetChannelAvatar("channelID", "8AFDAB98B48A90F7D3B18AFF96F0852C")
+		require.NoError(t, err)
+		assert.Equal(t, testPNGImage, avatar.Data)
+	}
+
+	// when cache key is not safe to use as file name
+	_, isCacheable := c.getAvatarCachePath("../avatar")
+	assert.False(t, isCacheable)
+}
+
+func TestGetOwnAvatar(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": {"pk": "pubkey"}}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return(getTestAvatarResponse(), nil),
+	)
+
+	avatar, err := c.GetOwnAvatar()
+	require.NoError(t, err)
+	assert.Equal(t, consts.ImageFormatPNG, avatar.Format)
+}
+
+func TestSetProfileAvatar(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when image format is unknown
+	err := c.SetProfileAvatar(bytes.NewReader([]byte("GIF89a")))
+	require.ErrorIs(t, err, ErrorImageFormat)
+
+	// when everything is ok
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.SetProfileAvatar(bytes.NewReader(testPNGImage)))
+}
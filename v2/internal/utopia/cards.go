@@ -0,0 +1,120 @@
+package utopia
+
+import (
+	"time"
+	"unicode/utf8"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+// CreateCard - create crypto card & get its ID.
+// finance limits are checked before sending the request
+func (c *UtopiaClient) CreateCard(task structs.CreateCardTask) (string, error) {
+	if utf8.RuneCountInString(task.Prefix) > maxCardPrefixLength {
+		return "", ErrorCardPrefixTooLong
+	}
+
+	if err := c.checkCardsLimits(task); err != nil {
+		return "", err
+	}
+
+	params := uMap{"name": task.Name}.
+		add("color", task.Color).
+		add("preorderNumber", task.Prefix)
+	return c.queryResultToString(reqAddCard, params)
+}
+
+func (c *UtopiaClient) checkCardsLimits(task structs.CreateCardTask) error {
+	info, err := c.GetFinanceInfo()
+	if err != nil {
+		return err
+	}
+	if !info.CRP.CardsCreationEnabled {
+		return ErrorCardsDisabled
+	}
+	if task.MaxPrice > 0 && info.CRP.GetCardCreatePrice(task.Prefix) > task.MaxPrice {
+		return ErrorCardPriceTooHigh
+	}
+
+	if info.CRP.CardsMaxActive > 0 {
+		cards, err := c.GetCards()
+		if err != nil {
+			return err
+		}
+		if uint(len(cards)) >= info.CRP.CardsMaxActive {
+			return ErrorCardsMaxActive
+		}
+	}
+
+	if info.CRP.CardsMaxPerDay > 0 {
+		count, err := c.countCardsCreatedSince(
+			time.Now().Add(-24*time.Hour),
+			info.CRP.CardsMaxPerDay,
+		)
+		if err != nil {
+			return err
+		}
+		if count >= info.CRP.CardsMaxPerDay {
+			return ErrorCardsMaxPerDay
+		}
+	}
+	return nil
+}
+
+// countCardsCreatedSince counts cards creations in the finance history,
+// so cards deleted after the creation are counted too. no more than limit is counted
+func (c *UtopiaClient) countCardsCreatedSince(from time.Time, limit uint) (uint, error) {
+	records, err := c.GetFinanceHistory(structs.GetFinanceHistoryTask{
+		Filter:         consts.FinanceHistoryCreatedCards,
+		FromDate:       from,
+		QueryLimitRows: limit,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return uint(len(records)), nil
+}
+
+// GetCards - get own crypto cards with balances
+func (c *UtopiaClient) GetCards() ([]structs.Card, error) {
+	r := []structs.Card{}
+	err := c.getSimpleStruct(reqGetCards, &r)
+	return r, err
+}
+
+// DeleteCard - delete crypto card. card balance is returned to the account
+func (c *UtopiaClient) DeleteCard(cardID string) error {
+	if cardID == "" {
+		return ErrorCardIDUnset
+	}
+
+	_, err := c.queryResultToString(reqDeleteCard, uMap{"cardId": cardID})
+	return err
+}
+
+// RenameCard - change crypto card name
+func (c *UtopiaClient) RenameCard(cardID, name string) error {
+	if cardID == "" {
+		return ErrorCardIDUnset
+	}
+
+	_, err := c.queryResultToString(reqChangeCardName, uMap{
+		"cardId":  cardID,
+		"newName": name,
+	})
+	return err
+}
+
+// SetCardColor - change crypto card color. example: #FBEDC0
+func (c *UtopiaClient) SetCardColor(cardID, color string) error {
+	if cardID == "" {
+		return ErrorCardIDUnset
+	}
+
+	_, err := c.queryResultToString(reqChangeCardColor, uMap{
+		"cardId":   cardID,
+		"newColor": color,
+	})
+	return err
+}
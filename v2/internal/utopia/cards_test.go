@@ -0,0 +1,115 @@
+package utopia
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestCreateCard(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": {"CRP": {
+				"cardsCreationEnabled": true,
+				"cardsMaxActive": 10,
+				"cardsMaxPerDay": 2
+			}}}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": [{"cardid": "1", "created": "2020-01-01T00:00:00Z"}]}`), nil),
+		// one card created today
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": [{"id": 1}]}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": "2"}`), nil),
+	)
+
+	cardID, err := c.CreateCard(structs.CreateCardTask{Name: "test"})
+	require.NoError(t, err)
+	assert.Equal(t, "2", cardID)
+}
+
+func TestCreateCardLimits(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when prefix is too long
+	_, err := c.CreateCard(structs.CreateCardTask{Prefix: "ABCDE"})
+	require.ErrorIs(t, err, ErrorCardPrefixTooLong)
+
+	// when cards creation is disabled
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"CRP": {"cardsCreationEnabled": false}}}`), nil)
+
+	_, err = c.CreateCard(structs.CreateCardTask{})
+	require.ErrorIs(t, err, ErrorCardsDisabled)
+
+	// when price is too high
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"CRP": {
+			"cardsCreationEnabled": true,
+			"cardCreatePrice10": 100
+		}}}`), nil)
+
+	_, err = c.CreateCard(structs.CreateCardTask{Prefix: "A", MaxPrice: 10})
+	require.ErrorIs(t, err, ErrorCardPriceTooHigh)
+
+	// when max active cards count reached
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"CRP": {"cardsCreationEnabled": true, "cardsMaxActive": 1}}}`), nil)
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": [{"cardid": "1"}]}`), nil)
+
+	_, err = c.CreateCard(structs.CreateCardTask{})
+	require.ErrorIs(t, err, ErrorCardsMaxActive)
+
+	// when max cards per day count reached, deleted cards are counted too
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"CRP": {"cardsCreationEnabled": true, "cardsMaxPerDay": 1}}}`), nil)
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+			q := query{}
+			require.NoError(t, json.Unmarshal(data, &q))
+			assert.Equal(t, reqGetFinanceHistory, q.Method)
+			assert.Equal(t, string(consts.FinanceHistoryCreatedCards), q.Params["filters"])
+			assert.NotEmpty(t, q.Params["fromDate"])
+			return []byte(`{"result": [{"id": 1}]}`), nil
+		})
+
+	_, err = c.CreateCard(structs.CreateCardTask{})
+	require.ErrorIs(t, err, ErrorCardsMaxPerDay)
+}
+
+func TestGetCards(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": [{"cardid": "1", "balance": 10.5}]}`), nil)
+
+	cards, err := c.GetCards()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(cards))
+	assert.Equal(t, 10.5, cards[0].Balance)
+}
+
+func TestModifyCard(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	require.ErrorIs(t, c.DeleteCard(""), ErrorCardIDUnset)
+	require.ErrorIs(t, c.RenameCard("", "name"), ErrorCardIDUnset)
+	require.ErrorIs(t, c.SetCardColor("", "#FFFFFF"), ErrorCardIDUnset)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3).Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.DeleteCard("1"))
+	require.NoError(t, c.RenameCard("1", "name"))
+	require.NoError(t, c.SetCardColor("1", "#FFFFFF"))
+}
@@ -0,0 +1,159 @@
+package utopia
+
+import (
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+const (
+	channelTypePublic  = "public"
+	channelTypePrivate = "private"
+)
+
+// CreateChannel - create channel & get its ID
+func (c *UtopiaClient) CreateChannel(task structs.CreateChannelTask) (string, error) {
+	if task.Title == "" {
+		return "", ErrorChannelTitleUnset
+	}
+
+	channelType := channelTypePublic
+	if task.IsPrivate {
+		if task.Password == "" {
+			return "", ErrorChannelPassword
+		}
+		channelType = channelTypePrivate
+	}
+
+	params := uMap{
+		"channel_type":      channelType,
+		"channel_name":      task.Title,
+		"read_only":         task.ReadOnly,
+		"read_only_privacy": task.ReadOnlyPrivacy,
+		"hide_in_UI":        task.HideInCommonList,
+	}.
+		add("description", task.Description).
+		add("password", task.Password).
+		add("geo_tag", task.GeoTag).
+		add("hash_tags", task.HashTags).
+		add("languages", task.Languages)
+	return c.queryResultToString(reqCreateChannel, params)
+}
+
+// ModifyChannel - update channel settings. only set fields of the task are sent,
+// other settings stay unchanged
+func (c *UtopiaClient) ModifyChannel(channelID string, task structs.ModifyChannelTask) error {
+	if channelID == "" {
+		return ErrorChannelIDUnset
+	}
+
+	params := uMap{"channelid": channelID}.
+		add("title", task.Title).
+		add("description", task.Description).
+		add("read_only", task.ReadOnly).
+		add("read_only_privacy", task.ReadOnlyPrivacy).
+		add("hide_in_UI", task.HideInCommonList).
+		add("geo_tag", task.GeoTag).
+		add("hash_tags", task.HashTags).
+		add("languages", task.Languages)
+
+	_, err := c.queryResultToBool(reqModifyChannel, params)
+	return err
+}
+
+// DeleteChannel - delete own channel. password is required for a private channel
+func (c *UtopiaClient) DeleteChannel(channelID string, password ...string) error {
+	if channelID == "" {
+		return ErrorChannelIDUnset
+	}
+
+	params := uMap{"channelid": channelID}
+	if len(password) > 0 {
+		params["password"] = password[0]
+	}
+	_, err := c.queryResultToBool(reqDeleteChannel, params)
+	return err
+}
+
+// LeaveChannel - leave joined channel
+func (c *UtopiaClient) LeaveChannel(channelID string) error {
+	if channelID == "" {
+		return ErrorChannelIDUnset
+	}
+
+	_, err := c.queryResultToBool(reqLeaveChannel, uMap{"channelid": channelID})
+	return err
+}
+
+// ReplyChannelMessage - send channel message in reply to the message & get new message ID
+func (c *UtopiaClient) ReplyChannelMessage(
+	channelID string,
+	messageID uint64,
+	message string,
+) (string, error) {
+	return c.sendChannelMessageWithRef(channelID, "replyId", messageID, message)
+}
+
+// QuoteChannelMessage - send channel message quoting the message & get new message ID
+func (c *UtopiaClient) QuoteChannelMessage(
+	channelID string,
+	messageID uint64,
+	message string,
+) (string, error) {
+	return c.sendChannelMessageWithRef(channelID, "quoteId", messageID, message)
+}
+
+func (c *UtopiaClient) sendChannelMessageWithRef(
+	channelID, refParam string,
+	messageID uint64,
+	message string,
+) (string, error) {
+	if err := checkChannelMessageRef(channelID, messageID); err != nil {
+		return "", err
+	}
+
+	return c.queryResultToString(reqSendChannelMessage, uMap{
+		"channelid": channelID,
+		"message":   message,
+		refParam:    messageID,
+	})
+}
+
+// EditChannelMessage - change text of the own channel message
+func (c *UtopiaClient) EditChannelMessage(channelID string, messageID uint64, message string) error {
+	return c.channelMessageQuery(reqEditChannelMessage, channelID, messageID, uMap{
+		"message": message,
+	})
+}
+
+// PinChannelMessage - pin channel message
+func (c *UtopiaClient) PinChannelMessage(channelID string, messageID uint64) error {
+	return c.channelMessageQuery(reqPinChannelMessage, channelID, messageID, uMap{})
+}
+
+// UnpinChannelMessage - unpin channel message
+func (c *UtopiaClient) UnpinChannelMessage(channelID string, messageID uint64) error {
+	return c.channelMessageQuery(reqUnpinChannelMessage, channelID, messageID, uMap{})
+}
+
+func (c *UtopiaClient) channelMessageQuery(
+	method, channelID string,
+	messageID uint64,
+	params uMap,
+) error {
+	if err := checkChannelMessageRef(channelID, messageID); err != nil {
+		return err
+	}
+
+	params.set("channelid", channelID).set("id_message", messageID)
+	_, err := c.queryResultToBool(method, params)
+	return err
+}
+
+func checkChannelMessageRef(channelID string, messageID uint64) error {
+	if channelID == "" {
+		return ErrorChannelIDUnset
+	}
+	if messageID == 0 {
+		return ErrorMessageIDUnset
+	}
+	return nil
+}
@@ -0,0 +1,150 @@
+package utopia
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestCreateChannel(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when title is not set
+	_, err := c.CreateChannel(structs.CreateChannelTask{})
+	require.ErrorIs(t, err, ErrorChannelTitleUnset)
+
+	// when private channel password is not set
+	_, err = c.CreateChannel(structs.CreateChannelTask{Title: "test", IsPrivate: true})
+	require.ErrorIs(t, err, ErrorChannelPassword)
+
+	// when everything is ok
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+			q := query{}
+			require.NoError(t, json.Unmarshal(data, &q))
+			assert.Equal(t, reqCreateChannel, q.Method)
+			assert.Equal(t, channelTypePrivate, q.Params["channel_type"])
+			assert.Equal(t, "#news", q.Params["hash_tags"])
+			return []byte(`{"result": "F10383EA72AC6263C21F356CD8D2E2A2"}`), nil
+		})
+
+	channelID, err := c.CreateChannel(structs.CreateChannelTask{
+		Title:     "test",
+		IsPrivate: true,
+		Password:  "secret",
+		HashTags:  "#news",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "F10383EA72AC6263C21F356CD8D2E2A2", channelID)
+}
+
+func TestChannelActions(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when channel ID is not set
+	require.ErrorIs(t, c.ModifyChannel("", structs.ModifyChannelTask{}), ErrorChannelIDUnset)
+	require.ErrorIs(t, c.DeleteChannel(""), ErrorChannelIDUnset)
+	require.ErrorIs(t, c.LeaveChannel(""), ErrorChannelIDUnset)
+
+	// when everything is ok
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+			q := query{}
+			require.NoError(t, json.Unmarshal(data, &q))
+
+			// only set fields are sent
+			assert.Equal(t, map[string]interface{}{
+				"channelid": "channelID",
+				"title":     "test",
+				"read_only": false,
+			}, q.Params)
+			return []byte(`{"result": true}`), nil
+		})
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).Return([]byte(`{"result": true}`), nil)
+
+	title, readOnly := "test", false
+	require.NoError(t, c.ModifyChannel("channelID", structs.ModifyChannelTask{
+		Title:    &title,
+		ReadOnly: &readOnly,
+	}))
+	require.NoError(t, c.DeleteChannel("channelID", "secret"))
+	require.NoError(t, c.LeaveChannel("channelID"))
+}
+
+func TestReplyChannelMessage(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when message ID is not set
+	_, err := c.ReplyChannelMessage("channelID", 0, "text")
+	require.ErrorIs(t, err, ErrorMessageIDUnset)
+
+	// when everything is ok
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+				q := query{}
+				require.NoError(t, json.Unmarshal(data, &q))
+				assert.Equal(t, float64(15), q.Params["replyId"])
+				return []byte(`{"result": "16"}`), nil
+			}),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+				q := query{}
+				require.NoError(t, json.Unmarshal(data, &q))
+				assert.Equal(t, float64(15), q.Params["quoteId"])
+				return []byte(`{"result": "17"}`), nil
+			}),
+	)
+
+	messageID, err := c.ReplyChannelMessage("channelID", 15, "text")
+	require.NoError(t, err)
+	assert.Equal(t, "16", messageID)
+
+	messageID, err = c.QuoteChannelMessage("channelID", 15, "text")
+	require.NoError(t, err)
+	assert.Equal(t, "17", messageID)
+}
+
+func TestChannelMessageActions(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when channel ID is not set
+	require.ErrorIs(t, c.PinChannelMessage("", 15), ErrorChannelIDUnset)
+
+	// when everything is ok
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3).Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.EditChannelMessage("channelID", 15, "edited"))
+	require.NoError(t, c.PinChannelMessage("channelID", 15))
+	require.NoError(t, c.UnpinChannelMessage("channelID", 15))
+}
+
+func TestChannelMessageRefs(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": [{
+			"id": 16,
+			"text": "answer",
+			"reply": {"id": 15, "nick": "author", "text": "question"}
+		}, {
+			"id": 17,
+			"text": "plain"
+		}]}`), nil)
+
+	messages, err := c.GetChannelMessages("channelID", 0, 10)
+	require.NoError(t, err)
+	require.Len(t, messages, 2)
+	require.NotNil(t, messages[0].ReplyTo)
+	assert.Equal(t, uint64(15), messages[0].ReplyTo.ID)
+	assert.Nil(t, messages[1].ReplyTo)
+	assert.Nil(t, messages[1].Quote)
+}
@@ -1,6 +1,7 @@
 package utopia
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -8,7 +9,9 @@ import (
 
 	"github.com/Sagleft/utopialib-go/v2/internal/reqhandler"
 	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	uerrors "github.com/Sagleft/utopialib-go/v2/pkg/errors"
 	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+	"github.com/Sagleft/utopialib-go/v2/pkg/websocket"
 	"github.com/beefsack/go-rate"
 )
 
@@ -26,11 +29,15 @@ func NewUtopiaClient(data Config) *UtopiaClient {
 		data.Protocol = defaultProtocol
 	}
 
-	return &UtopiaClient{
+	c := &UtopiaClient{
+		ctx:        context.Background(),
 		reqHandler: reqhandler.NewDefaultHandler(timeoutDuration),
 		data:       data,
+		logger:     data.Logger,
 		limiters:   getRateLimiters(),
 	}
+	c.broker = websocket.NewBroker(c.getWsURL(), c.getSubscribeTask())
+	return c
 }
 
 func getRateLimiters() rateLimiters {
@@ -41,14 +48,48 @@ func getRateLimiters() rateLimiters {
 	}
 }
 
-func (c *UtopiaClient) limitRate(method string) {
+// WithContext returns a shallow copy of the client whose requests are bound to ctx.
+// rate limiters and the request handler are shared with the original client
+func (c *UtopiaClient) WithContext(ctx context.Context) *UtopiaClient {
+	if ctx == nil {
+		panic("nil context")
+	}
+
+	c2 := *c
+	c2.ctx = ctx
+	return &c2
+}
+
+// limitRate waits for the method rate limiter or until the client context is done
+func (c *UtopiaClient) limitRate(method string) error {
 	limiter, isExists := c.limiters[method]
 	if !isExists {
-		c.limiters[reqDefault].Wait()
-		return
+		limiter = c.limiters[reqDefault]
+	}
+
+	for {
+		ok, remaining := limiter.Try()
+		if ok {
+			return nil
+		}
+
+		if err := c.sleep(remaining); err != nil {
+			return err
+		}
 	}
+}
 
-	limiter.Wait()
+// sleep pauses for the duration or until the client context is done
+func (c *UtopiaClient) sleep(d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-c.ctx.Done():
+		return c.ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
 func (c *UtopiaClient) GetProfileStatus() (structs.ProfileStatus, error) {
@@ -120,7 +161,7 @@ func (c *UtopiaClient) GetFinanceHistory(task structs.GetFinanceHistoryTask) (
 ) {
 	params := uMap{}.
 		add("currency", task.Currency).
-		add("filters", task.Filters).
+		add("filters", string(task.Filter)).
 		add("referenceNumber", task.ReferenceNumber).
 		add("batchId", task.BatchID).
 		add("fromAmount", task.FromAmount).
@@ -140,7 +181,7 @@ func (c *UtopiaClient) GetFinanceHistory(task structs.GetFinanceHistoryTask) (
 		add("limitRows", task.QueryLimitRows)
 
 	r := []structs.FinanceHistoryData{}
-	err := c.retrieveStruct("", params, filters, &r)
+	err := c.retrieveStruct(reqGetFinanceHistory, params, filters, &r)
 	return r, err
 }
 
@@ -299,7 +340,7 @@ func (c *UtopiaClient) GetContacts(filter string) ([]structs.ContactData, error)
 	}
 
 	data := []structs.ContactData{}
-	if err := convertResult(response, &data); err != nil {
+	if err := convertResult(reqGetContacts, response, &data); err != nil {
 		return nil, err
 	}
 	return data, nil
@@ -312,7 +353,7 @@ func (c *UtopiaClient) GetContact(pubkeyOrNick string) (structs.ContactData, err
 	}
 
 	if len(contacts) == 0 {
-		return structs.ContactData{}, errors.New("contact not found")
+		return structs.ContactData{}, uerrors.NewAPIError(reqGetContacts, "contact not found")
 	}
 	return contacts[0], nil
 }
@@ -338,7 +379,7 @@ func (c *UtopiaClient) GetChannelContacts(channelID string) (
 	}
 
 	data := []structs.ChannelContactData{}
-	if err := convertResult(response, &data); err != nil {
+	if err := convertResult(reqGetChannelContacts, response, &data); err != nil {
 		return nil, err
 	}
 	return data, nil
@@ -377,7 +418,7 @@ func (c *UtopiaClient) GetChannelMessages(
 	}
 
 	data := []structs.ChannelMessage{}
-	if err := convertResult(response, &data); err != nil {
+	if err := convertResult(reqGetChannelMessages, response, &data); err != nil {
 		return nil, err
 	}
 	return data, nil
@@ -423,7 +464,7 @@ func (c *UtopiaClient) GetChannelInfo(channelID string) (structs.ChannelData, er
 	}
 
 	data := structs.ChannelData{}
-	if err := convertResult(response, &data); err != nil {
+	if err := convertResult(reqGetChannelInfo, response, &data); err != nil {
 		return structs.ChannelData{}, err
 	}
 
@@ -464,7 +505,7 @@ func (c *UtopiaClient) GetChannels(task structs.GetChannelsTask) (
 	}
 
 	data := []structs.SearchChannelData{}
-	if err := convertResult(response, &data); err != nil {
+	if err := convertResult(reqGetChannels, response, &data); err != nil {
 		return nil, err
 	}
 
@@ -491,7 +532,7 @@ func (c *UtopiaClient) GetNetworkConnections() ([]structs.PeerInfo, error) {
 	}
 
 	data := structs.PeersInfoContainer{}
-	if err := convertResult(response, &data); err != nil {
+	if err := convertResult(reqGetNetworkConnections, response, &data); err != nil {
 		return nil, err
 	}
 
@@ -515,7 +556,7 @@ func (c *UtopiaClient) GetChannelModeratorRights(
 		return data, ErrorChannelIDUnset
 	}
 	if moderatorPubkey == "" {
-		return data, errors.New("moderator pubkey must be set")
+		return data, ErrorModeratorUnset
 	}
 
 	if err := c.retrieveStruct(reqGetChannelModeratorRight, uMap{
@@ -537,7 +578,7 @@ func (c *UtopiaClient) GetChannelModerators(channelID string) ([]string, error)
 	}
 
 	data := []string{}
-	if err := convertResult(response, &data); err != nil {
+	if err := convertResult(reqGetChannelModerators, response, &data); err != nil {
 		return nil, err
 	}
 	return data, nil
@@ -1,6 +1,8 @@
 package utopia
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"testing"
 	"time"
@@ -11,6 +13,7 @@ import (
 
 	mocks "github.com/Sagleft/utopialib-go/v2/internal/mocks"
 	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	uerrors "github.com/Sagleft/utopialib-go/v2/pkg/errors"
 	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
 )
 
@@ -33,14 +36,50 @@ func TestNewUtopiaClient(t *testing.T) {
 
 func TestLimitRate(t *testing.T) {
 	_, c := getTestClient(t)
-	c.limitRate("test")
-	c.limitRate(reqDefault)
+	require.NoError(t, c.limitRate("test"))
+	require.NoError(t, c.limitRate(reqDefault))
+}
+
+func TestLimitRateCanceled(t *testing.T) {
+	_, c := getTestClient(t)
+
+	// when rate limit is reached
+	for i := 0; i < defaultRequestsPerSecond; i++ {
+		require.NoError(t, c.limitRate(reqDefault))
+	}
+
+	// then waiting is interrupted by context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := c.WithContext(ctx).limitRate(reqDefault)
+	require.ErrorIs(t, err, context.Canceled)
+}
+
+func TestWithContextCanceled(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	handlerMock.EXPECT().Send(ctx, gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(context.Context, string, string, []byte) ([]byte, error) {
+			cancel()
+			return nil, errors.New("request aborted")
+		})
+
+	_, err := c.WithContext(ctx).GetBalance()
+	require.ErrorIs(t, err, context.Canceled)
+
+	var canceledErr *uerrors.CanceledError
+	require.ErrorAs(t, err, &canceledErr)
+	assert.Equal(t, reqGetBalance, canceledErr.Method)
+
+	// original client is not bound to canceled context
+	assert.NoError(t, c.ctx.Err())
 }
 
 func TestGetProfileStatus(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		[]byte(`{
 			"result": {
 				"mood": "[snowleo]",
@@ -60,7 +99,7 @@ func TestGetProfileStatus(t *testing.T) {
 func TestGetSystemInfo(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		[]byte(`{"result": {}}`), nil,
 	)
 
@@ -72,7 +111,7 @@ func TestSetProfileStatusNoError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
 	// when all is ok
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		AnyTimes().Return([]byte(`{"result": {}}`), nil)
 
 	// then
@@ -83,7 +122,7 @@ func TestSetProfileStatusError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
 	// when error was given
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		AnyTimes().Return(nil, errors.New("test error"))
 
 	// then
@@ -94,7 +133,7 @@ func TestSetProfileStatusUnsuccess(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
 	// when error was given
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		AnyTimes().Return([]byte(`{"result":false}`), nil)
 
 	// then
@@ -105,7 +144,7 @@ func TestSetProfileStatusUnsuccess(t *testing.T) {
 func TestGetOwnContact(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(
 		[]byte(`{
 			"result": {
 				"avatarMd5": "8AFDAB98B48A90F7D3B18AFF96F0852C",
@@ -131,7 +170,7 @@ func TestGetOwnContact(t *testing.T) {
 func TestCheckClientConnectionSuccess(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":{}}`), nil)
 
 	require.True(t, c.CheckClientConnection())
@@ -140,7 +179,7 @@ func TestCheckClientConnectionSuccess(t *testing.T) {
 func TestCheckClientConnectionUnsuccess(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil, ErrorClientDisconnected)
 
 	require.False(t, c.CheckClientConnection())
@@ -149,7 +188,7 @@ func TestCheckClientConnectionUnsuccess(t *testing.T) {
 func TestUseVoucher(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":{}}`), nil)
 
 	_, err := c.UseVoucher("123-456-789")
@@ -159,7 +198,7 @@ func TestUseVoucher(t *testing.T) {
 func TestGetFinanceInfo(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":{}}`), nil)
 
 	_, err := c.GetFinanceInfo()
@@ -169,14 +208,14 @@ func TestGetFinanceInfo(t *testing.T) {
 func TestGetFinanceHistory(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":[{},{}]}`), nil)
 
 	data, err := c.GetFinanceHistory(structs.GetFinanceHistoryTask{})
 	require.NoError(t, err)
 	assert.Equal(t, 2, len(data))
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":[]}`), nil)
 
 	_, err = c.GetFinanceHistory(structs.GetFinanceHistoryTask{
@@ -186,10 +225,40 @@ func TestGetFinanceHistory(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestGetFinanceHistoryRequest(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+			q := query{}
+			require.NoError(t, json.Unmarshal(data, &q))
+			assert.Equal(t, reqGetFinanceHistory, q.Method)
+			assert.Equal(t, string(consts.FinanceHistoryIncomingTransfers), q.Params["filters"])
+			assert.Equal(t, float64(10), q.Filters["limitRows"])
+
+			return []byte(`{"result":[{
+				"id": 1,
+				"amount": 5,
+				"isIncoming": true,
+				"sourcePk": "SENDER",
+				"destinationPk": "RECEIVER"
+			}]}`), nil
+		})
+
+	data, err := c.GetFinanceHistory(structs.GetFinanceHistoryTask{
+		Filter:         consts.FinanceHistoryIncomingTransfers,
+		QueryLimitRows: 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(data))
+	assert.Equal(t, float64(5), data[0].Amount)
+	assert.Equal(t, "SENDER", data[0].CounterpartyPubkey())
+}
+
 func TestGetBalance(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":0}`), nil)
 
 	_, err := c.GetBalance()
@@ -199,7 +268,7 @@ func TestGetBalance(t *testing.T) {
 func TestGetUUSDBalance(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":0}`), nil)
 
 	_, err := c.GetUUSDBalance()
@@ -209,7 +278,7 @@ func TestGetUUSDBalance(t *testing.T) {
 func TestCreateVoucher(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":""}`), nil)
 
 	_, err := c.CreateVoucher(100)
@@ -219,7 +288,7 @@ func TestCreateVoucher(t *testing.T) {
 func TestCreateUUSDVoucher(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":""}`), nil)
 
 	_, err := c.CreateUUSDVoucher(100)
@@ -229,7 +298,7 @@ func TestCreateUUSDVoucher(t *testing.T) {
 func TestSetWebSocketState(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":"ok"}`), nil)
 
 	require.NoError(t, c.SetWebSocketState(structs.SetWsStateTask{
@@ -241,7 +310,7 @@ func TestSetWebSocketState(t *testing.T) {
 func TestSetWebSocketStateEmptyResult(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":""}`), nil)
 
 	require.Error(t, c.SetWebSocketState(structs.SetWsStateTask{}))
@@ -250,7 +319,7 @@ func TestSetWebSocketStateEmptyResult(t *testing.T) {
 func TestSetWebSocketStateError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{}`), nil)
 
 	require.Error(t, c.SetWebSocketState(structs.SetWsStateTask{}))
@@ -259,7 +328,7 @@ func TestSetWebSocketStateError(t *testing.T) {
 func TestGetWebSocketState(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":0}`), nil)
 
 	_, err := c.GetWebSocketState()
@@ -269,7 +338,7 @@ func TestGetWebSocketState(t *testing.T) {
 func TestGetWebSocketStateError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{}`), nil)
 
 	_, err := c.GetWebSocketState()
@@ -279,7 +348,7 @@ func TestGetWebSocketStateError(t *testing.T) {
 func TestSendChannelMessage(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":""}`), nil)
 
 	_, err := c.SendChannelMessage("", "")
@@ -289,7 +358,7 @@ func TestSendChannelMessage(t *testing.T) {
 func TestSendChannelContactMessage(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":""}`), nil)
 
 	_, err := c.SendChannelContactMessage("", "", "")
@@ -299,7 +368,7 @@ func TestSendChannelContactMessage(t *testing.T) {
 func TestSendChannelPicture(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":""}`), nil)
 
 	_, err := c.SendChannelPicture("", "", "", "")
@@ -309,7 +378,7 @@ func TestSendChannelPicture(t *testing.T) {
 func TestGetStickerNamesByCollection(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":[]}`), nil)
 
 	_, err := c.GetStickerNamesByCollection("")
@@ -319,7 +388,7 @@ func TestGetStickerNamesByCollection(t *testing.T) {
 func TestGetStickerImage(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":""}`), nil)
 
 	_, err := c.GetStickerImage("", "")
@@ -329,7 +398,7 @@ func TestGetStickerImage(t *testing.T) {
 func TestUCodeEncode(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":""}`), nil)
 
 	_, err := c.UCodeEncode("", "", "", 256)
@@ -339,7 +408,7 @@ func TestUCodeEncode(t *testing.T) {
 func TestSendAuthRequest(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":true}`), nil)
 
 	_, err := c.SendAuthRequest("", "")
@@ -349,7 +418,7 @@ func TestSendAuthRequest(t *testing.T) {
 func TestAcceptAuthRequest(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":true}`), nil)
 
 	_, err := c.AcceptAuthRequest("", "")
@@ -359,7 +428,7 @@ func TestAcceptAuthRequest(t *testing.T) {
 func TestRejectAuthRequest(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":true}`), nil)
 
 	_, err := c.RejectAuthRequest("", "")
@@ -369,7 +438,7 @@ func TestRejectAuthRequest(t *testing.T) {
 func TestSendInstantMessage(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":0}`), nil)
 
 	_, err := c.SendInstantMessage("", "")
@@ -379,7 +448,7 @@ func TestSendInstantMessage(t *testing.T) {
 func TestGetContacts(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result":[]}`), nil)
 
 	_, err := c.GetContacts("")
@@ -389,7 +458,7 @@ func TestGetContacts(t *testing.T) {
 func TestGetContactsError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`invalid json`), nil)
 
 	_, err := c.GetContacts("")
@@ -399,7 +468,7 @@ func TestGetContactsError(t *testing.T) {
 func TestGetContactsError2(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{}`), nil)
 
 	_, err := c.GetContacts("")
@@ -409,7 +478,7 @@ func TestGetContactsError2(t *testing.T) {
 func TestGetContact(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": [{}]}`), nil)
 
 	_, err := c.GetContact("")
@@ -419,7 +488,7 @@ func TestGetContact(t *testing.T) {
 func TestGetContactError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`invalid json`), nil)
 
 	_, err := c.GetContact("")
@@ -429,7 +498,7 @@ func TestGetContactError(t *testing.T) {
 func TestGetContactNotFound(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": []}`), nil)
 
 	_, err := c.GetContact("")
@@ -439,7 +508,7 @@ func TestGetContactNotFound(t *testing.T) {
 func TestJoinChannel(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": true}`), nil)
 
 	_, err := c.JoinChannel("", "")
@@ -449,7 +518,7 @@ func TestJoinChannel(t *testing.T) {
 func TestGetChannelContacts(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": []}`), nil)
 
 	_, err := c.GetChannelContacts("")
@@ -459,7 +528,7 @@ func TestGetChannelContacts(t *testing.T) {
 func TestGetChannelContactsError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{}`), nil)
 
 	_, err := c.GetChannelContacts("")
@@ -469,7 +538,7 @@ func TestGetChannelContactsError(t *testing.T) {
 func TestGetChannelContactsError2(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`invalid json`), nil)
 
 	_, err := c.GetChannelContacts("")
@@ -479,7 +548,7 @@ func TestGetChannelContactsError2(t *testing.T) {
 func TestEnableChannelReadOnly(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": true}`), nil)
 
 	require.Nil(t, c.EnableChannelReadOnly("", true))
@@ -488,7 +557,7 @@ func TestEnableChannelReadOnly(t *testing.T) {
 func TestRemoveChannelMessage(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": ""}`), nil)
 
 	require.Nil(t, c.RemoveChannelMessage("", 1000000))
@@ -497,7 +566,7 @@ func TestRemoveChannelMessage(t *testing.T) {
 func TestGetChannelMessages(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": [{}]}`), nil)
 
 	_, err := c.GetChannelMessages("", 0, 1)
@@ -507,7 +576,7 @@ func TestGetChannelMessages(t *testing.T) {
 func TestGetChannelMessagesNotFound(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": []}`), nil)
 
 	data, err := c.GetChannelMessages("", 0, 1)
@@ -518,13 +587,13 @@ func TestGetChannelMessagesNotFound(t *testing.T) {
 func TestGetChannelMessagesError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`invalid json`), nil)
 
 	_, err := c.GetChannelMessages("", 0, 1)
 	require.Error(t, err)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{}`), nil)
 
 	_, err = c.GetChannelMessages("", 0, 1)
@@ -534,7 +603,7 @@ func TestGetChannelMessagesError(t *testing.T) {
 func TestSendPayment(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
 		Return([]byte(`{"result": ""}`), nil)
 
 	// when comment is too long
@@ -572,7 +641,7 @@ func TestSendPayment(t *testing.T) {
 func TestGetChannelInfo(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": {}}`), nil)
 
 	_, err := c.GetChannelInfo("")
@@ -582,13 +651,13 @@ func TestGetChannelInfo(t *testing.T) {
 func TestGetChannelInfoError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": false}`), nil)
 
 	_, err := c.GetChannelInfo("")
 	require.Error(t, err)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`invalid json`), nil)
 
 	_, err = c.GetChannelInfo("")
@@ -598,7 +667,7 @@ func TestGetChannelInfoError(t *testing.T) {
 func TestGetChannels(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": []}`), nil)
 
 	_, err := c.GetChannels(structs.GetChannelsTask{
@@ -611,13 +680,13 @@ func TestGetChannels(t *testing.T) {
 func TestGetChannelsError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{}`), nil)
 
 	_, err := c.GetChannels(structs.GetChannelsTask{})
 	require.Error(t, err)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`invalid json`), nil)
 
 	_, err = c.GetChannels(structs.GetChannelsTask{})
@@ -627,7 +696,7 @@ func TestGetChannelsError(t *testing.T) {
 func TestGetChannelsVariants(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes().
 		Return([]byte(`{"result": []}`), nil)
 
 	_, err := c.GetChannels(structs.GetChannelsTask{
@@ -659,7 +728,7 @@ func TestGetChannelsVariants(t *testing.T) {
 func TestToogleChannelNotifications(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": true}`), nil)
 
 	require.Nil(t, c.ToogleChannelNotifications("", true))
@@ -668,7 +737,7 @@ func TestToogleChannelNotifications(t *testing.T) {
 func TestToogleChannelNotificationsError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{}`), nil)
 
 	require.Error(t, c.ToogleChannelNotifications("", true))
@@ -677,7 +746,7 @@ func TestToogleChannelNotificationsError(t *testing.T) {
 func TestGetNetworkConnections(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{"result": {"connections":[{},{}]}}`), nil)
 
 	peers, err := c.GetNetworkConnections()
@@ -688,13 +757,13 @@ func TestGetNetworkConnections(t *testing.T) {
 func TestGetNetworkConnectionsError(t *testing.T) {
 	handlerMock, c := getTestClient(t)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`invalid json`), nil)
 
 	_, err := c.GetNetworkConnections()
 	require.Error(t, err)
 
-	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any()).
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return([]byte(`{}`), nil)
 
 	_, err = c.GetNetworkConnections()
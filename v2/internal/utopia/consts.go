@@ -14,6 +14,11 @@ const (
 	defaultProtocol               = "http"
 	defaultTimeLayout             = time.RFC3339
 	defaultRequestsPerSecond      = 5
+	defaultRetryBaseDelay         = 500 * time.Millisecond
+	defaultRetryMaxDelay          = 10 * time.Second
+	defaultPageSize               = 100
+	defaultVoucherBatchTimeout    = 30 * time.Second
+	defaultVoucherBatchPoll       = time.Second
 
 	reqDefault                     = "default"
 	reqGetProfileStatus            = "getProfileStatus"
@@ -51,8 +56,108 @@ const (
 	reqAcceptAuthorizationRequest  = "acceptAuthorizationRequest"
 	reqSendAuthorizationRequest    = "sendAuthorizationRequest"
 	reqGetChannelModerators        = "getChannelModerators"
+	reqUNSCreateRecordRequest      = "unsCreateRecordRequest"
+	reqUNSModifyRecordRequest      = "unsModifyRecordRequest"
+	reqUNSDeleteRecordRequest      = "unsDeleteRecordRequest"
+	reqUNSSearchByPk               = "unsSearchByPk"
+	reqUNSSearchByNick             = "unsSearchByNick"
+	reqUNSRegisteredNames          = "unsRegisteredNames"
+	reqRequestUNSTransfer          = "requestUnsTransfer"
+	reqAcceptUNSTransfer           = "acceptUnsTransfer"
+	reqDeclineUNSTransfer          = "declineUnsTransfer"
+	reqIncomingUNSTransfer         = "incomingUnsTransfer"
+	reqOutgoingUNSTransfer         = "outgoingUnsTransfer"
+	reqAddCard                     = "addCard"
+	reqGetCards                    = "getCards"
+	reqDeleteCard                  = "deleteCard"
+	reqChangeCardName              = "changeCardName"
+	reqChangeCardColor             = "changeCardColor"
+	reqSendInvoice                 = "sendInvoice"
+	reqGetInvoices                 = "getInvoices"
+	reqGetInvoiceByReferenceNumber = "getInvoiceByReferenceNumber"
+	reqAcceptInvoice               = "acceptInvoice"
+	reqDeclineInvoice              = "declineInvoice"
+	reqCancelInvoice               = "cancelInvoice"
+	reqGetVouchers                 = "getVouchers"
+	reqDeleteVoucher               = "deleteVoucher"
+	reqGetMiningInfo               = "getMiningInfo"
+	reqGetMiningBlocks             = "getMiningBlocks"
+	reqEnableMining                = "enableMining"
+	reqGetEmailFolder              = "getEmailFolder"
+	reqGetEmails                   = "getEmails"
+	reqGetEmailByID                = "getEmailById"
+	reqSendEmailMessage            = "sendEmailMessage"
+	reqSendReplyEmailMessage       = "sendReplyEmailMessage"
+	reqSendForwardEmailMessage     = "sendForwardEmailMessage"
+	reqDeleteEmail                 = "deleteEmail"
+	reqGetContactMessages          = "getContactMessages"
+	reqRemoveContact               = "removeContact"
+	reqSetContactNick              = "setContactNick"
+	reqSetContactGroup             = "setContactGroup"
+	reqGetContactGroups            = "getContactGroups"
+	reqGetContactsByGroup          = "getContactsByGroup"
+	reqRenameContactGroup          = "renameContactGroup"
+	reqDeleteContactGroup          = "deleteContactGroup"
+	reqBlockContact                = "blockContact"
+	reqUnblockContact              = "unblockContact"
+	reqGetContactAvatar            = "getContactAvatar"
+	reqGetChannelAvatar            = "getChannelAvatar"
+	reqSetProfileAvatar            = "setProfileAvatar"
+	reqCreateChannel               = "createChannel"
+	reqDeleteChannel               = "deleteChannel"
+	reqLeaveChannel                = "leaveChannel"
+	reqGetChannelBannedContacts    = "getChannelBannedContacts"
+	reqApplyChannelBannedContacts  = "applyChannelBannedContacts"
+	reqAddChannelModerator         = "addChannelModerator"
+	reqRemoveChannelModerator      = "removeChannelModerator"
+	reqSetChannelModeratorRight    = "setChannelModeratorRight"
+	reqEditChannelMessage          = "editChannelMessage"
+	reqPinChannelMessage           = "pinChannelMessage"
+	reqUnpinChannelMessage         = "unpinChannelMessage"
 )
 
+// readOnlyMethods - methods that are safe to retry
+var readOnlyMethods = map[string]struct{}{
+	reqGetProfileStatus:            {},
+	reqGetSystemInfo:               {},
+	reqGetOwnContact:               {},
+	reqGetFinanceSystemInformation: {},
+	reqGetFinanceHistory:           {},
+	reqGetChannels:                 {},
+	reqGetChannelInfo:              {},
+	reqGetBalance:                  {},
+	reqGetWebSocketState:           {},
+	reqGetStickerNamesByCollection: {},
+	reqGetImageSticker:             {},
+	reqUcodeEncode:                 {},
+	reqGetChannelContacts:          {},
+	reqGetChannelModeratorRight:    {},
+	reqGetNetworkConnections:       {},
+	reqGetChannelMessages:          {},
+	reqGetContacts:                 {},
+	reqGetChannelModerators:        {},
+	reqUNSSearchByPk:               {},
+	reqUNSSearchByNick:             {},
+	reqUNSRegisteredNames:          {},
+	reqIncomingUNSTransfer:         {},
+	reqOutgoingUNSTransfer:         {},
+	reqGetCards:                    {},
+	reqGetInvoices:                 {},
+	reqGetInvoiceByReferenceNumber: {},
+	reqGetVouchers:                 {},
+	reqGetMiningInfo:               {},
+	reqGetMiningBlocks:             {},
+	reqGetEmailFolder:              {},
+	reqGetEmails:                   {},
+	reqGetEmailByID:                {},
+	reqGetContactMessages:          {},
+	reqGetContactGroups:            {},
+	reqGetContactsByGroup:          {},
+	reqGetContactAvatar:            {},
+	reqGetChannelAvatar:            {},
+	reqGetChannelBannedContacts:    {},
+}
+
 const (
 	coinCRP  = "CRP"
 	coinUUSD = "UUSD"
@@ -60,9 +165,44 @@ const (
 
 const syncProgressDigits = 2
 
+const unsDateLayout = "2006-01-02"
+
+const maxCardPrefixLength = 4
+
 var (
-	ErrorSetProfileStatus   = errors.New("failed to set profile status")
-	ErrorSetProfileData     = errors.New("failed to set profile data")
-	ErrorClientDisconnected = errors.New("client disconected")
-	ErrorChannelIDUnset     = errors.New("channel ID must be set")
+	ErrorSetProfileStatus     = errors.New("failed to set profile status")
+	ErrorSetProfileData       = errors.New("failed to set profile data")
+	ErrorClientDisconnected   = errors.New("client disconected")
+	ErrorChannelIDUnset       = errors.New("channel ID must be set")
+	ErrorUNSNameUnset         = errors.New("uNS name must be set")
+	ErrorUNSFeeTooHigh        = errors.New("uNS name registration fee is higher than max fee")
+	ErrorUNSTransferIDUnset   = errors.New("uNS transfer request ID must be set")
+	ErrorCardIDUnset          = errors.New("card ID must be set")
+	ErrorCardPrefixTooLong    = errors.New("card prefix is too long")
+	ErrorCardsDisabled        = errors.New("cards creation is disabled")
+	ErrorCardsMaxActive       = errors.New("max active cards count reached")
+	ErrorCardsMaxPerDay       = errors.New("max cards per day count reached")
+	ErrorCardPriceTooHigh     = errors.New("card create price is higher than max price")
+	ErrorInvoiceIDUnset       = errors.New("invoice ID must be set")
+	ErrorInvoicesDisabled     = errors.New("invoices are disabled")
+	ErrorVoucherIDUnset       = errors.New("voucher ID must be set")
+	ErrorVouchersMaxActive    = errors.New("max active vouchers count reached")
+	ErrorVouchersMaxBatch     = errors.New("vouchers count is more than max per batch")
+	ErrorVoucherBatchWait     = errors.New("timeout waiting for vouchers batch")
+	ErrorUnknownCurrency      = errors.New("unknown currency")
+	ErrorMiningUnavailable    = errors.New("mining is not available for the account")
+	ErrorEmailToUnset         = errors.New("email recipients must be set")
+	ErrorEmailIDUnset         = errors.New("email ID must be set")
+	ErrorPubkeyUnset          = errors.New("contact pubkey must be set")
+	ErrorGroupNameUnset       = errors.New("contact group name must be set")
+	ErrorImageFormat          = errors.New("image format is not supported, PNG or JPG expected")
+	ErrorChannelTitleUnset    = errors.New("channel title must be set")
+	ErrorChannelPassword      = errors.New("password must be set for a private channel")
+	ErrorModeratorUnset       = errors.New("moderator pubkey must be set")
+	ErrorPubkeyHashUnset      = errors.New("contact pubkey hash must be set")
+	ErrorDateTimeFormat       = errors.New("unknown dateTime format")
+	ErrorChannelHistoryCursor = errors.New("channel history page has no messages after the cursor")
+	ErrorMessageIDUnset       = errors.New("message ID must be set")
+
+	errResultNotFound = errors.New("acceptable result doesn't exist in client response")
 )
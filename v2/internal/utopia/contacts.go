@@ -0,0 +1,99 @@
+package utopia
+
+import (
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func (c *UtopiaClient) contactQuery(method, pubkey string, params uMap) error {
+	if pubkey == "" {
+		return ErrorPubkeyUnset
+	}
+
+	_, err := c.queryResultToString(method, params.set("contactPublicKey", pubkey))
+	return err
+}
+
+// RemoveContact - remove user from contacts
+func (c *UtopiaClient) RemoveContact(pubkey string) error {
+	return c.contactQuery(reqRemoveContact, pubkey, uMap{})
+}
+
+// SetContactNick - set local nickname for the contact
+func (c *UtopiaClient) SetContactNick(pubkey, nick string) error {
+	return c.contactQuery(reqSetContactNick, pubkey, uMap{"newNick": nick})
+}
+
+// SetContactGroup - move the contact to the group.
+// the group is created when it doesn't exist
+func (c *UtopiaClient) SetContactGroup(pubkey, groupName string) error {
+	return c.contactQuery(reqSetContactGroup, pubkey, uMap{"groupName": groupName})
+}
+
+// BlockContact - add the contact to the black list
+func (c *UtopiaClient) BlockContact(pubkey string) error {
+	return c.contactQuery(reqBlockContact, pubkey, uMap{})
+}
+
+// UnblockContact - remove the contact from the black list
+func (c *UtopiaClient) UnblockContact(pubkey string) error {
+	return c.contactQuery(reqUnblockContact, pubkey, uMap{})
+}
+
+// GetContactGroups - get contact group names
+func (c *UtopiaClient) GetContactGroups() ([]string, error) {
+	return c.queryResultToStringsArray(reqGetContactGroups, uMap{})
+}
+
+// GetContactsByGroup - get the contacts in the group
+func (c *UtopiaClient) GetContactsByGroup(groupName string) ([]structs.ContactData, error) {
+	if groupName == "" {
+		return nil, ErrorGroupNameUnset
+	}
+
+	r := []structs.ContactData{}
+	err := c.retrieveStruct(reqGetContactsByGroup, uMap{"groupName": groupName}, uMap{}, &r)
+	return r, err
+}
+
+// RenameContactGroup - change contact group name
+func (c *UtopiaClient) RenameContactGroup(oldName, newName string) error {
+	if oldName == "" || newName == "" {
+		return ErrorGroupNameUnset
+	}
+
+	_, err := c.queryResultToString(reqRenameContactGroup, uMap{
+		"oldGroupName": oldName,
+		"newGroupName": newName,
+	})
+	return err
+}
+
+// DeleteContactGroup - delete contact group. group contacts are moved to the default group
+func (c *UtopiaClient) DeleteContactGroup(groupName string) error {
+	if groupName == "" {
+		return ErrorGroupNameUnset
+	}
+
+	_, err := c.queryResultToString(reqDeleteContactGroup, uMap{"groupName": groupName})
+	return err
+}
+
+// GetContactMessages - get messages history with the contact
+func (c *UtopiaClient) GetContactMessages(
+	pubkey string,
+	offset int,
+	limit int,
+) ([]structs.InstantMessage, error) {
+	if pubkey == "" {
+		return nil, ErrorPubkeyUnset
+	}
+
+	params := uMap{"pk": pubkey}
+	filters := uMap{}.
+		add("offset", offset).
+		add("limit", limit)
+
+	r := []structs.InstantMessage{}
+	err := c.retrieveStruct(reqGetContactMessages, params, filters, &r)
+	return r, err
+}
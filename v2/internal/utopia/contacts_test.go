@@ -0,0 +1,75 @@
+package utopia
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContactActions(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when pubkey is not set
+	require.ErrorIs(t, c.RemoveContact(""), ErrorPubkeyUnset)
+	require.ErrorIs(t, c.SetContactNick("", "nick"), ErrorPubkeyUnset)
+
+	// when everything is ok
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(5).Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.RemoveContact("pubkey"))
+	require.NoError(t, c.SetContactNick("pubkey", "nick"))
+	require.NoError(t, c.SetContactGroup("pubkey", "friends"))
+	require.NoError(t, c.BlockContact("pubkey"))
+	require.NoError(t, c.UnblockContact("pubkey"))
+}
+
+func TestContactGroups(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": ["friends", "work"]}`), nil)
+
+	groups, err := c.GetContactGroups()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"friends", "work"}, groups)
+
+	// when group name is not set
+	_, err = c.GetContactsByGroup("")
+	require.ErrorIs(t, err, ErrorGroupNameUnset)
+	require.ErrorIs(t, c.RenameContactGroup("", "new"), ErrorGroupNameUnset)
+	require.ErrorIs(t, c.DeleteContactGroup(""), ErrorGroupNameUnset)
+
+	// when everything is ok
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": [{"nick": "friend", "group": "friends"}]}`), nil)
+
+	contacts, err := c.GetContactsByGroup("friends")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(contacts))
+	assert.Equal(t, "friends", contacts[0].Group)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.RenameContactGroup("friends", "best friends"))
+	require.NoError(t, c.DeleteContactGroup("best friends"))
+}
+
+func TestGetContactMessages(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when pubkey is not set
+	_, err := c.GetContactMessages("", 0, 10)
+	require.ErrorIs(t, err, ErrorPubkeyUnset)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": [{"id": 1, "text": "hello"}]}`), nil)
+
+	messages, err := c.GetContactMessages("pubkey", 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(messages))
+	assert.Equal(t, "hello", messages[0].Text)
+}
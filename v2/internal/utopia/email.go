@@ -0,0 +1,93 @@
+package utopia
+
+import (
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+// GetEmailFolder - get IDs of the emails in the folder.
+// filter is optional: part of the subject, body or sender
+func (c *UtopiaClient) GetEmailFolder(folder consts.EmailFolder, filter string) ([]uint64, error) {
+	params := uMap{"folderType": folder}.add("filter", filter)
+
+	r := []uint64{}
+	err := c.retrieveStruct(reqGetEmailFolder, params, uMap{}, &r)
+	return r, err
+}
+
+// GetEmails - get the emails in the folder.
+// filter is optional: part of the subject, body or sender
+func (c *UtopiaClient) GetEmails(folder consts.EmailFolder, filter string) ([]structs.Email, error) {
+	params := uMap{"folderType": folder}.add("filter", filter)
+
+	r := []structs.Email{}
+	err := c.retrieveStruct(reqGetEmails, params, uMap{}, &r)
+	return r, err
+}
+
+// GetEmail - get email by ID
+func (c *UtopiaClient) GetEmail(emailID uint64) (structs.Email, error) {
+	r := structs.Email{}
+	if emailID == 0 {
+		return r, ErrorEmailIDUnset
+	}
+
+	err := c.retrieveStruct(reqGetEmailByID, uMap{"id": emailID}, uMap{}, &r)
+	return r, err
+}
+
+// SendEmail - send uMail message
+func (c *UtopiaClient) SendEmail(task structs.SendEmailTask) error {
+	if len(task.To) == 0 {
+		return ErrorEmailToUnset
+	}
+
+	_, err := c.queryResultToString(reqSendEmailMessage, uMap{
+		"to":      task.To,
+		"subject": task.Subject,
+		"body":    task.Body,
+	})
+	return err
+}
+
+// ReplyEmail - send reply to the email
+func (c *UtopiaClient) ReplyEmail(emailID uint64, subject, body string) error {
+	if emailID == 0 {
+		return ErrorEmailIDUnset
+	}
+
+	_, err := c.queryResultToString(reqSendReplyEmailMessage, uMap{
+		"id":      emailID,
+		"subject": subject,
+		"body":    body,
+	})
+	return err
+}
+
+// ForwardEmail - forward the email to recipients
+func (c *UtopiaClient) ForwardEmail(emailID uint64, task structs.SendEmailTask) error {
+	if emailID == 0 {
+		return ErrorEmailIDUnset
+	}
+	if len(task.To) == 0 {
+		return ErrorEmailToUnset
+	}
+
+	_, err := c.queryResultToString(reqSendForwardEmailMessage, uMap{
+		"id":      emailID,
+		"to":      task.To,
+		"subject": task.Subject,
+		"body":    task.Body,
+	})
+	return err
+}
+
+// DeleteEmail - move the email to trash or delete it when it's already in trash
+func (c *UtopiaClient) DeleteEmail(emailID uint64) error {
+	if emailID == 0 {
+		return ErrorEmailIDUnset
+	}
+
+	_, err := c.queryResultToString(reqDeleteEmail, uMap{"id": emailID})
+	return err
+}
@@ -0,0 +1,69 @@
+package utopia
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestGetEmailFolder(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": [1, 2]}`), nil)
+
+	ids, err := c.GetEmailFolder(consts.EmailFolderInbox, "")
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 2}, ids)
+}
+
+func TestGetEmails(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": [{"id": 1, "subject": "test", "folder": 1}]}`), nil)
+
+	emails, err := c.GetEmails(consts.EmailFolderInbox, "test")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(emails))
+	assert.Equal(t, consts.EmailFolderInbox, emails[0].Folder)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"id": 1, "subject": "test"}}`), nil)
+
+	email, err := c.GetEmail(1)
+	require.NoError(t, err)
+	assert.Equal(t, "test", email.Subject)
+}
+
+func TestSendEmail(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	require.ErrorIs(t, c.SendEmail(structs.SendEmailTask{}), ErrorEmailToUnset)
+	require.ErrorIs(t, c.ForwardEmail(1, structs.SendEmailTask{}), ErrorEmailToUnset)
+
+	// when email ID is not set
+	_, err := c.GetEmail(0)
+	require.ErrorIs(t, err, ErrorEmailIDUnset)
+	require.ErrorIs(t, c.ReplyEmail(0, "re: test", "test"), ErrorEmailIDUnset)
+	require.ErrorIs(t, c.ForwardEmail(0, structs.SendEmailTask{To: []string{"pubkey"}}), ErrorEmailIDUnset)
+	require.ErrorIs(t, c.DeleteEmail(0), ErrorEmailIDUnset)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(4).Return([]byte(`{"result": true}`), nil)
+
+	task := structs.SendEmailTask{
+		To:      []string{"pubkey"},
+		Subject: "test",
+		Body:    "test",
+	}
+	require.NoError(t, c.SendEmail(task))
+	require.NoError(t, c.ReplyEmail(1, "re: test", "test"))
+	require.NoError(t, c.ForwardEmail(1, task))
+	require.NoError(t, c.DeleteEmail(1))
+}
@@ -0,0 +1,106 @@
+package utopia
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+// SendInvoice - issue invoice & get its reference number.
+// invoices state & min amount are checked before sending,
+// TTL is taken from the finance info when it's not set
+func (c *UtopiaClient) SendInvoice(task structs.SendInvoiceTask) (string, error) {
+	if task.CardID == "" {
+		return "", ErrorCardIDUnset
+	}
+	if task.Amount <= 0 {
+		return "", errors.New("amount is not set")
+	}
+	if len(task.Comment) > maxCharactersInPaymentComment {
+		return "", fmt.Errorf(
+			"comment max length is %v characters",
+			maxCharactersInPaymentComment,
+		)
+	}
+
+	info, err := c.GetFinanceInfo()
+	if err != nil {
+		return "", err
+	}
+	if !info.CRP.InvoicesEnabled {
+		return "", ErrorInvoicesDisabled
+	}
+	if task.Amount < info.CRP.InvoicesMinAmount {
+		return "", fmt.Errorf("invoice min amount is %v", info.CRP.InvoicesMinAmount)
+	}
+
+	ttl := task.TTL
+	if ttl == 0 {
+		ttl = info.CRP.InvoicesDefaultTtl
+	}
+
+	params := uMap{
+		"cardid": task.CardID,
+		"amount": task.Amount,
+	}.add("comment", task.Comment).add("ttl", ttl)
+	return c.queryResultToString(reqSendInvoice, params)
+}
+
+// GetInvoices - get incoming & outgoing invoices with filters
+func (c *UtopiaClient) GetInvoices(task structs.GetInvoicesTask) ([]structs.Invoice, error) {
+	params := uMap{}.
+		add("cardid", task.CardID).
+		add("invoiceId", task.InvoiceID).
+		add("pk", task.Pubkey).
+		add("transactionId", task.TransactionID).
+		add("status", string(task.Status)).
+		add("referenceNumber", task.ReferenceNumber)
+
+	if !task.FromDate.IsZero() {
+		params["startDateTime"] = task.FromDate.Format(defaultTimeLayout)
+	}
+	if !task.ToDate.IsZero() {
+		params["endDateTime"] = task.ToDate.Format(defaultTimeLayout)
+	}
+
+	r := []structs.Invoice{}
+	err := c.retrieveStruct(reqGetInvoices, params, uMap{}, &r)
+	return r, err
+}
+
+// GetInvoiceByReferenceNumber - find invoice by reference number
+func (c *UtopiaClient) GetInvoiceByReferenceNumber(referenceNumber string) (
+	structs.Invoice,
+	error,
+) {
+	r := structs.Invoice{}
+	err := c.retrieveStruct(reqGetInvoiceByReferenceNumber, uMap{
+		"referenceNumber": referenceNumber,
+	}, uMap{}, &r)
+	return r, err
+}
+
+func (c *UtopiaClient) processInvoice(method, invoiceID string) error {
+	if invoiceID == "" {
+		return ErrorInvoiceIDUnset
+	}
+
+	_, err := c.queryResultToString(method, uMap{"invoiceid": invoiceID})
+	return err
+}
+
+// AcceptInvoice - pay incoming invoice
+func (c *UtopiaClient) AcceptInvoice(invoiceID string) error {
+	return c.processInvoice(reqAcceptInvoice, invoiceID)
+}
+
+// DeclineInvoice - decline incoming invoice
+func (c *UtopiaClient) DeclineInvoice(invoiceID string) error {
+	return c.processInvoice(reqDeclineInvoice, invoiceID)
+}
+
+// CancelInvoice - cancel own outgoing invoice
+func (c *UtopiaClient) CancelInvoice(invoiceID string) error {
+	return c.processInvoice(reqCancelInvoice, invoiceID)
+}
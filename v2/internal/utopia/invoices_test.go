@@ -0,0 +1,110 @@
+package utopia
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestSendInvoice(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when card is not set
+	_, err := c.SendInvoice(structs.SendInvoiceTask{Amount: 1})
+	require.ErrorIs(t, err, ErrorCardIDUnset)
+
+	// when amount is not set
+	_, err = c.SendInvoice(structs.SendInvoiceTask{CardID: "1"})
+	require.Error(t, err)
+
+	// when everything is ok
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": {"CRP": {
+				"invoicesEnabled": true,
+				"invoicesMinAmount": 1,
+				"invoicesDefaultTtl": 3600
+			}}}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+				q := query{}
+				require.NoError(t, json.Unmarshal(data, &q))
+				assert.Equal(t, "1", q.Params["cardid"])
+				assert.Equal(t, float64(3600), q.Params["ttl"])
+				return []byte(`{"result": "REF123"}`), nil
+			}),
+	)
+
+	ref, err := c.SendInvoice(structs.SendInvoiceTask{CardID: "1", Amount: 10})
+	require.NoError(t, err)
+	assert.Equal(t, "REF123", ref)
+}
+
+func TestSendInvoiceLimits(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when invoices are disabled
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"CRP": {"invoicesEnabled": false}}}`), nil)
+
+	_, err := c.SendInvoice(structs.SendInvoiceTask{CardID: "1", Amount: 10})
+	require.ErrorIs(t, err, ErrorInvoicesDisabled)
+
+	// when amount is less than min amount
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"CRP": {"invoicesEnabled": true, "invoicesMinAmount": 100}}}`), nil)
+
+	_, err = c.SendInvoice(structs.SendInvoiceTask{CardID: "1", Amount: 10})
+	require.Error(t, err)
+}
+
+func TestGetInvoices(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+			q := query{}
+			require.NoError(t, json.Unmarshal(data, &q))
+			// same card param spelling as in sendInvoice
+			assert.Equal(t, "1", q.Params["cardid"])
+			return []byte(`{"result": [{"id": "1", "amount": 5, "status": "AWAITING_APPROVAL"}]}`), nil
+		})
+
+	invoices, err := c.GetInvoices(structs.GetInvoicesTask{
+		CardID:   "1",
+		Status:   consts.InvoiceStatusAwaiting,
+		FromDate: time.Now(),
+		ToDate:   time.Now(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(invoices))
+	assert.True(t, invoices[0].IsAwaiting())
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"id": "1", "referenceNumber": "REF123", "status": "APPROVED"}}`), nil)
+
+	invoice, err := c.GetInvoiceByReferenceNumber("REF123")
+	require.NoError(t, err)
+	assert.Equal(t, consts.InvoiceStatusAccepted, invoice.Status)
+}
+
+func TestProcessInvoice(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	require.ErrorIs(t, c.AcceptInvoice(""), ErrorInvoiceIDUnset)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3).Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.AcceptInvoice("1"))
+	require.NoError(t, c.DeclineInvoice("1"))
+	require.NoError(t, c.CancelInvoice("1"))
+}
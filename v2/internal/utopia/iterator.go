@@ -0,0 +1,258 @@
+package utopia
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+// pageFetcher requests the next page. isLast is true when there are no more pages
+type pageFetcher[T any] func(c *UtopiaClient) (page []T, isLast bool, err error)
+
+// Iterator pages through API results. usage:
+//
+//	for it.Next(ctx) {
+//		item := it.Item()
+//	}
+//	if err := it.Err(); err != nil { ... }
+type Iterator[T any] struct {
+	client    *UtopiaClient
+	fetchPage pageFetcher[T]
+	isStop    func(item T) (bool, error) // optional stop condition
+
+	page   []T
+	item   T
+	err    error
+	isLast bool
+	isDone bool
+}
+
+func newIterator[T any](c *UtopiaClient, fetchPage pageFetcher[T]) *Iterator[T] {
+	return &Iterator[T]{
+		client:    c,
+		fetchPage: fetchPage,
+	}
+}
+
+// Next advances the iterator to the next item, requesting next page when needed.
+// returns false when there are no more items, the stop condition is met or an error occurred
+func (it *Iterator[T]) Next(ctx context.Context) bool {
+	if it.isDone {
+		return false
+	}
+
+	if len(it.page) == 0 {
+		if it.isLast {
+			it.isDone = true
+			return false
+		}
+
+		it.page, it.isLast, it.err = it.fetchPage(it.client.WithContext(ctx))
+		if it.err != nil || len(it.page) == 0 {
+			it.isDone = true
+			return false
+		}
+	}
+
+	it.item, it.page = it.page[0], it.page[1:]
+	if it.isStop == nil {
+		return true
+	}
+
+	var isStop bool
+	isStop, it.err = it.isStop(it.item)
+	if it.err != nil || isStop {
+		it.isDone = true
+		return false
+	}
+	return true
+}
+
+// Item returns the current item
+func (it *Iterator[T]) Item() T {
+	return it.item
+}
+
+// Err returns the error that stopped the iteration
+func (it *Iterator[T]) Err() error {
+	return it.err
+}
+
+// GetFinanceHistoryIterator - iterate over the finance history page by page
+func (c *UtopiaClient) GetFinanceHistoryIterator(
+	task structs.FinanceHistoryIteratorTask,
+) *Iterator[structs.FinanceHistoryData] {
+	pageSize := task.PageSize
+	if pageSize == 0 {
+		pageSize = defaultPageSize
+	}
+
+	query := task.Query
+	query.QueryLimitRows = pageSize
+
+	it := newIterator(c, func(c *UtopiaClient) ([]structs.FinanceHistoryData, bool, error) {
+		page, err := c.GetFinanceHistory(query)
+		if err != nil {
+			return nil, false, err
+		}
+
+		query.QueryOffset += uint(len(page))
+		return page, uint(len(page)) < pageSize, nil
+	})
+
+	if !task.StopDate.IsZero() {
+		it.isStop = func(item structs.FinanceHistoryData) (bool, error) {
+			createdOn, err := parseDateTime(item.CreatedOn)
+			if err != nil {
+				return false, err
+			}
+			return createdOn.Before(task.StopDate), nil
+		}
+	}
+	return it
+}
+
+// GetContactMessagesIterator - iterate over messages history with the contact page by page
+func (c *UtopiaClient) GetContactMessagesIterator(
+	pubkey string,
+	pageSize int,
+) *Iterator[structs.InstantMessage] {
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	var offset int
+	return newIterator(c, func(c *UtopiaClient) ([]structs.InstantMessage, bool, error) {
+		page, err := c.GetContactMessages(pubkey, offset, pageSize)
+		if err != nil {
+			return nil, false, err
+		}
+
+		offset += len(page)
+		return page, len(page) < pageSize, nil
+	})
+}
+
+// GetChannelHistoryIterator - iterate over the channel messages by message ID cursor.
+// messages received during the iteration don't break the order:
+// every page continues from the last returned message ID
+func (c *UtopiaClient) GetChannelHistoryIterator(
+	task structs.ChannelHistoryIteratorTask,
+) *Iterator[structs.ChannelMessage] {
+	pageSize := task.PageSize
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+
+	isForward := task.Direction == consts.HistoryForward
+	cursor := task.FromID
+	it := newIterator(c, func(c *UtopiaClient) ([]structs.ChannelMessage, bool, error) {
+		if task.ChannelID == "" {
+			return nil, false, ErrorChannelIDUnset
+		}
+
+		// the cursor message can be included in the page,
+		// so one more message is requested to fill the page
+		limit := pageSize
+		if cursor != 0 {
+			limit++
+		}
+
+		page, err := c.getChannelMessagesPage(task.ChannelID, cursor, isForward, limit)
+		if err != nil {
+			return nil, false, err
+		}
+
+		isLast := len(page) < limit
+		page = filterMessagesAfterCursor(page, cursor, isForward)
+		if len(page) == 0 {
+			if !isLast {
+				// full page without new messages: cursor can't be advanced
+				return nil, false, ErrorChannelHistoryCursor
+			}
+			return nil, true, nil
+		}
+
+		cursor = page[len(page)-1].ID
+		return page, isLast, nil
+	})
+
+	it.isStop = func(item structs.ChannelMessage) (bool, error) {
+		if task.StopID != 0 && (isForward && item.ID >= task.StopID ||
+			!isForward && item.ID <= task.StopID) {
+			return true, nil
+		}
+		if task.StopDate.IsZero() {
+			return false, nil
+		}
+
+		createdOn, err := parseDateTime(item.DateTime)
+		if err != nil {
+			return false, err
+		}
+		return isForward && createdOn.After(task.StopDate) ||
+			!isForward && createdOn.Before(task.StopDate), nil
+	}
+	return it
+}
+
+func (c *UtopiaClient) getChannelMessagesPage(
+	channelID string,
+	cursor uint64,
+	isForward bool,
+	limit int,
+) ([]structs.ChannelMessage, error) {
+	params := uMap{
+		"channelid": channelID,
+		"forward":   isForward,
+	}.add("fromId", cursor)
+
+	r := []structs.ChannelMessage{}
+	if err := c.retrieveStruct(reqGetChannelMessages, params, uMap{
+		"limit": limit,
+	}, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// filterMessagesAfterCursor sorts the page in the iteration order and
+// drops messages which were already returned
+func filterMessagesAfterCursor(
+	page []structs.ChannelMessage,
+	cursor uint64,
+	isForward bool,
+) []structs.ChannelMessage {
+	sort.Slice(page, func(i, j int) bool {
+		if isForward {
+			return page[i].ID < page[j].ID
+		}
+		return page[i].ID > page[j].ID
+	})
+
+	if cursor == 0 {
+		return page
+	}
+
+	r := make([]structs.ChannelMessage, 0, len(page))
+	for _, message := range page {
+		if isForward && message.ID > cursor || !isForward && message.ID < cursor {
+			r = append(r, message)
+		}
+	}
+	return r
+}
+
+// parseDateTime parses the dateTime field in any of the API formats
+func parseDateTime(val string) (time.Time, error) {
+	for _, layout := range consts.DateTimeLayouts {
+		if t, err := time.Parse(layout, val); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%w: %q", ErrorDateTimeFormat, val)
+}
@@ -0,0 +1,230 @@
+package utopia
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestFinanceHistoryIterator(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 1},{"id": 2}]}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 3}]}`), nil),
+	)
+
+	it := c.GetFinanceHistoryIterator(structs.FinanceHistoryIteratorTask{PageSize: 2})
+
+	ids := []int64{}
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []int64{1, 2, 3}, ids)
+
+	// iterator is exhausted
+	assert.False(t, it.Next(context.Background()))
+}
+
+func TestFinanceHistoryIteratorStopDate(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result":[
+			{"id": 1, "created": "2022-09-10T05:47:52.972Z"},
+			{"id": 2, "created": "2022-09-08T05:47:52.972Z"}
+		]}`), nil)
+
+	it := c.GetFinanceHistoryIterator(structs.FinanceHistoryIteratorTask{
+		StopDate: time.Date(2022, 9, 9, 0, 0, 0, 0, time.UTC),
+	})
+
+	require.True(t, it.Next(context.Background()))
+	assert.Equal(t, int64(1), it.Item().ID)
+	require.False(t, it.Next(context.Background()))
+	require.NoError(t, it.Err())
+}
+
+func TestFinanceHistoryIteratorStopDateFormats(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result":[
+			{"id": 1, "created": "2022-09-10 05:47:52"},
+			{"id": 2, "created": "2022-09-09T05:47:52.972"},
+			{"id": 3, "created": "yesterday"}
+		]}`), nil)
+
+	it := c.GetFinanceHistoryIterator(structs.FinanceHistoryIteratorTask{
+		StopDate: time.Date(2022, 9, 1, 0, 0, 0, 0, time.UTC),
+	})
+
+	ids := []int64{}
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().ID)
+	}
+	assert.Equal(t, []int64{1, 2}, ids)
+
+	// when date can't be parsed
+	require.ErrorIs(t, it.Err(), ErrorDateTimeFormat)
+}
+
+func TestFinanceHistoryIteratorError(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("test error"))
+
+	it := c.GetFinanceHistoryIterator(structs.FinanceHistoryIteratorTask{})
+	require.False(t, it.Next(context.Background()))
+	require.Error(t, it.Err())
+}
+
+func TestContactMessagesIterator(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 1},{"id": 2}]}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[]}`), nil),
+	)
+
+	it := c.GetContactMessagesIterator("pubkey", 2)
+
+	ids := []int{}
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []int{1, 2}, ids)
+}
+
+func TestChannelHistoryIterator(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 9},{"id": 10}]}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+				q := query{}
+				require.NoError(t, json.Unmarshal(data, &q))
+				assert.Equal(t, float64(9), q.Params["fromId"])
+				assert.Equal(t, false, q.Params["forward"])
+				// one more message for the cursor one
+				assert.Equal(t, float64(3), q.Filters["limit"])
+
+				// already returned message is skipped
+				return []byte(`{"result":[{"id": 9},{"id": 8},{"id": 7}]}`), nil
+			}),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 7}]}`), nil),
+	)
+
+	it := c.GetChannelHistoryIterator(structs.ChannelHistoryIteratorTask{
+		ChannelID: "channelID",
+		PageSize:  2,
+	})
+
+	ids := []uint64{}
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []uint64{10, 9, 8, 7}, ids)
+}
+
+func TestChannelHistoryIteratorInclusiveCursor(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 10}]}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 10},{"id": 9}]}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 9},{"id": 8}]}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 8}]}`), nil),
+	)
+
+	it := c.GetChannelHistoryIterator(structs.ChannelHistoryIteratorTask{
+		ChannelID: "channelID",
+		PageSize:  1,
+	})
+
+	ids := []uint64{}
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Item().ID)
+	}
+	require.NoError(t, it.Err())
+	assert.Equal(t, []uint64{10, 9, 8}, ids)
+
+	// when full page has no messages after the cursor
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 10}]}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result":[{"id": 11},{"id": 10}]}`), nil),
+	)
+
+	it = c.GetChannelHistoryIterator(structs.ChannelHistoryIteratorTask{
+		ChannelID: "channelID",
+		PageSize:  1,
+	})
+	require.True(t, it.Next(context.Background()))
+	require.False(t, it.Next(context.Background()))
+	require.ErrorIs(t, it.Err(), ErrorChannelHistoryCursor)
+}
+
+func TestChannelHistoryIteratorForward(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	for _, response := range []string{
+		// when the cursor message is not returned
+		`{"result":[{"id": 6},{"id": 5},{"id": 7}]}`,
+		// when the cursor message is returned
+		`{"result":[{"id": 4},{"id": 6},{"id": 5},{"id": 7}]}`,
+	} {
+		response := response
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+				q := query{}
+				require.NoError(t, json.Unmarshal(data, &q))
+				assert.Equal(t, float64(4), q.Params["fromId"])
+				return []byte(response), nil
+			})
+
+		it := c.GetChannelHistoryIterator(structs.ChannelHistoryIteratorTask{
+			ChannelID: "channelID",
+			Direction: consts.HistoryForward,
+			FromID:    4,
+			StopID:    7,
+		})
+
+		ids := []uint64{}
+		for it.Next(context.Background()) {
+			ids = append(ids, it.Item().ID)
+		}
+		require.NoError(t, it.Err())
+		assert.Equal(t, []uint64{5, 6}, ids)
+	}
+
+	// when channel ID is not set
+	it := c.GetChannelHistoryIterator(structs.ChannelHistoryIteratorTask{})
+	require.False(t, it.Next(context.Background()))
+	require.ErrorIs(t, it.Err(), ErrorChannelIDUnset)
+}
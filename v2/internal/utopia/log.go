@@ -2,58 +2,132 @@ package utopia
 
 import (
 	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 )
 
-type LogCallback func(logMessage string)
+const (
+	logStatusSuccess = "success"
+	logStatusError   = "error"
+	redactedValue    = "[redacted]"
+)
+
+// sensitiveParams - request params which values are not passed to the logger.
+// keys are compared case-insensitively
+var sensitiveParams = map[string]struct{}{
+	"token":         {},
+	"password":      {},
+	"voucherid":     {},
+	"cardid":        {},
+	"sourcepk":      {},
+	"destinationpk": {},
+}
+
+// sensitiveMethodParams - params which are sensitive only for the method,
+// e.g. `to` is a recipient for payments but a date bound for searches
+var sensitiveMethodParams = map[string]map[string]struct{}{
+	reqSendPayment:             {"to": {}},
+	reqSendInstantMessage:      {"to": {}},
+	reqSendEmailMessage:        {"to": {}},
+	reqSendForwardEmailMessage: {"to": {}},
+}
+
+// maxLogValueLength - longer string values (e.g. base64 images) are truncated
+const maxLogValueLength = 256
+
+// Logger - receives API requests log entries
+type Logger interface {
+	LogRequest(entry RequestLog)
+}
+
+// LogFunc - use function as Logger
+type LogFunc func(entry RequestLog)
+
+func (f LogFunc) LogRequest(entry RequestLog) {
+	f(entry)
+}
 
-type logData struct {
-	TimeCreated time.Time
-	Timestamp   int64 // unix milli
-	APIURL      string
-	APIMethod   string
-	RequestType string
-	RequestData map[string]interface{}
+// RequestLog - API request log entry.
+// sensitive params are redacted, long values are truncated
+type RequestLog struct {
+	Time        time.Time
+	URL         string
+	Method      string // API method
+	RequestType string // POST
+	Params      map[string]interface{}
 	Filters     map[string]interface{}
-	Response    []byte
+	RequestSize int // request body size in bytes
+	Status      string
+	Duration    time.Duration
 	Error       error
-	Elapsed     string
 }
 
-func (l *logData) setElapsedTime() {
-	l.Elapsed = time.Since(l.TimeCreated).String()
+func newRequestLog(
+	URL, methodName string,
+	params, filters map[string]interface{},
+) *RequestLog {
+	return &RequestLog{
+		Time:        time.Now(),
+		URL:         URL,
+		Method:      methodName,
+		RequestType: "POST",
+		Params:      redactParams(methodName, params),
+		Filters:     redactParams(methodName, filters),
+		Status:      logStatusSuccess,
+	}
 }
 
-func (l *logData) useError(err error) error {
-	l.Error = err
-	l.setElapsedTime()
-	return err
+func redactParams(methodName string, params map[string]interface{}) map[string]interface{} {
+	if params == nil {
+		return nil
+	}
+
+	r := make(map[string]interface{}, len(params))
+	for key, value := range params {
+		if isSensitiveParam(methodName, key) {
+			value = redactedValue
+		}
+		r[key] = truncateLogValue(value)
+	}
+	return r
+}
+
+func isSensitiveParam(methodName, key string) bool {
+	key = strings.ToLower(key)
+	if _, isSensitive := sensitiveParams[key]; isSensitive {
+		return true
+	}
+
+	_, isSensitive := sensitiveMethodParams[methodName][key]
+	return isSensitive
 }
 
-func (l *logData) getMessage() string {
-	status := "success"
-	if l.Error != nil {
-		status = "error: " + l.Error.Error()
+func truncateLogValue(value interface{}) interface{} {
+	s, isString := value.(string)
+	if !isString || len(s) <= maxLogValueLength {
+		return value
 	}
 
-	return fmt.Sprintf(
-		"%v: %s %s -> %s. elapsed %s: %s\nrequest: %q\nfilters: %q\nresponse: %q",
-		l.Timestamp,
-		l.RequestType,
-		l.APIURL,
-		l.APIMethod,
-		l.Elapsed,
-		status,
-		l.RequestData,
-		l.Filters,
-		string(l.Response),
-	)
-}
-
-func (l *logData) handle(cb LogCallback) {
-	if cb == nil {
+	// don't split multi-byte characters
+	cut := maxLogValueLength
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s...[truncated, %d bytes]", s[:cut], len(s))
+}
+
+func (l *RequestLog) useError(err error) error {
+	l.Error = err
+	l.Status = logStatusError
+	return err
+}
+
+func (l *RequestLog) handle(logger Logger) {
+	if logger == nil {
 		return
 	}
 
-	cb(l.getMessage())
+	l.Duration = time.Since(l.Time)
+	logger.LogRequest(*l)
 }
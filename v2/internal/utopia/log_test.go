@@ -2,22 +2,165 @@ package utopia
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uerrors "github.com/Sagleft/utopialib-go/v2/pkg/errors"
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
 )
 
-func TestLogData(t *testing.T) {
+func TestRequestLog(t *testing.T) {
 	// when everything is ok
-	l := logData{}
+	l := newRequestLog("url", reqUseVoucher, uMap{"voucherid": "secret"}, uMap{"cardId": "secret"})
 	// then
-	assert.NotEqual(t, "", l.getMessage())
+	assert.Equal(t, logStatusSuccess, l.Status)
+	assert.Equal(t, redactedValue, l.Params["voucherid"])
+	assert.Equal(t, redactedValue, l.Filters["cardId"])
 
 	// when error is set
-	l.Error = errors.New("test error")
+	err := l.useError(errors.New("test error"))
 	// then
-	assert.NotEqual(t, "", l.getMessage())
+	require.Error(t, err)
+	assert.Equal(t, logStatusError, l.Status)
+
+	// when logger is not set
+	l.handle(nil)
+}
+
+func TestLoggerFromConfig(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	entries := []RequestLog{}
+	c.logger = LogFunc(func(entry RequestLog) {
+		entries = append(entries, entry)
+	})
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result":"ok"}`), nil)
+
+	_, err := c.UseVoucher("123-456-789")
+	require.NoError(t, err)
+
+	require.Equal(t, 1, len(entries))
+	assert.Equal(t, reqUseVoucher, entries[0].Method)
+	assert.Equal(t, logStatusSuccess, entries[0].Status)
+	assert.Equal(t, redactedValue, entries[0].Params["voucherid"])
+	assert.NotZero(t, entries[0].RequestSize)
+}
+
+func TestLoggerAPIError(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	entries := []RequestLog{}
+	c.logger = LogFunc(func(entry RequestLog) {
+		entries = append(entries, entry)
+	})
+
+	// when API returns an error
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"error": "Not enough funds"}`), nil)
+	// when response can't be decoded
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`invalid json`), nil)
+
+	_, err := c.UseVoucher("123-456-789")
+	require.Error(t, err)
+	_, err = c.UseVoucher("123-456-789")
+	require.Error(t, err)
+
+	require.Equal(t, 2, len(entries))
+	assert.Equal(t, logStatusError, entries[0].Status)
+	var apiErr *uerrors.APIError
+	require.ErrorAs(t, entries[0].Error, &apiErr)
+	assert.Equal(t, "Not enough funds", apiErr.Message)
+
+	assert.Equal(t, logStatusError, entries[1].Status)
+	require.ErrorIs(t, entries[1].Error, uerrors.ErrDecode)
+}
+
+func TestNewUtopiaClientLogger(t *testing.T) {
+	logger := LogFunc(func(entry RequestLog) {})
+	c := NewUtopiaClient(Config{Logger: logger})
+	assert.NotNil(t, c.logger)
+}
+
+func TestSensitiveParamsRedacted(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	entries := []RequestLog{}
+	c.logger = LogFunc(func(entry RequestLog) {
+		entries = append(entries, entry)
+	})
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		AnyTimes().Return([]byte(`{"result": true}`), nil)
+
+	const (
+		cardID   = "SECRET-CARD"
+		pubkey   = "SECRET-PUBKEY"
+		voucher  = "SECRET-VOUCHER"
+		password = "SECRET-PASSWORD"
+	)
+
+	// results are not checked: only the logged params matter
+	_ = c.DeleteCard(cardID)
+	_ = c.RenameCard(cardID, "name")
+	_ = c.SetCardColor(cardID, "#FBEDC0")
+	_, _ = c.GetInvoices(structs.GetInvoicesTask{CardID: cardID})
+	_, _ = c.GetFinanceHistory(structs.GetFinanceHistoryTask{
+		SourcePubkey:      pubkey,
+		DestinationPubkey: pubkey,
+	})
+	_, _ = c.SendPayment(structs.SendPaymentTask{To: pubkey, Amount: 1, FromCardID: cardID})
+	_, _ = c.UseVoucher(voucher)
+	_ = c.DeleteVoucher(voucher)
+	_, _ = c.JoinChannel("channelID", password)
+	_ = c.DeleteChannel("channelID", password)
+	_, _ = c.CreateChannel(structs.CreateChannelTask{
+		Title:     "test",
+		IsPrivate: true,
+		Password:  password,
+	})
+	_, _ = c.SendInstantMessage(pubkey, "text")
+	_ = c.SendEmail(structs.SendEmailTask{To: []string{pubkey}})
+
+	require.Len(t, entries, 13)
+	for _, entry := range entries {
+		for key, value := range entry.Params {
+			assert.NotContains(t, fmt.Sprint(value), "SECRET", "%s: %s", entry.Method, key)
+		}
+	}
+}
+
+func TestRedactParams(t *testing.T) {
+	// when `to` is a date bound
+	params := redactParams(reqGetChannels, uMap{"to": "2022-09-09T05:47:52Z"})
+	assert.Equal(t, "2022-09-09T05:47:52Z", params["to"])
+
+	// when `to` is a payment recipient
+	params = redactParams(reqSendPayment, uMap{"to": "pubkey"})
+	assert.Equal(t, redactedValue, params["to"])
+
+	// when value is too long
+	image := strings.Repeat("A", 10000)
+	params = redactParams(reqSetProfileAvatar, uMap{"base64_image": image})
+	value := params["base64_image"].(string)
+	assert.Less(t, len(value), 300)
+	assert.Contains(t, value, "10000 bytes")
+
+	// when long value has multi-byte characters
+	text := "a" + strings.Repeat("привет", 100)
+	params = redactParams(reqSendChannelMessage, uMap{"message": text})
+	value = params["message"].(string)
+	assert.True(t, utf8.ValidString(value))
+	assert.True(t, strings.HasPrefix(text, strings.Split(value, "...[truncated")[0]))
 
-	// when callback is set
-	l.handle(func(logMessage string) {})
+	// when params are not set
+	assert.Nil(t, redactParams(reqGetChannels, nil))
 }
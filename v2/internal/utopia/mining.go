@@ -0,0 +1,43 @@
+package utopia
+
+import (
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+// GetMiningInfo - get mining state
+func (c *UtopiaClient) GetMiningInfo() (structs.MiningInfo, error) {
+	r := structs.MiningInfo{}
+	err := c.getSimpleStruct(reqGetMiningInfo, &r)
+	return r, err
+}
+
+// GetMiningBlocks - get mined blocks with rewards
+func (c *UtopiaClient) GetMiningBlocks(task structs.GetMiningBlocksTask) (
+	[]structs.MiningBlock,
+	error,
+) {
+	filters := uMap{}.
+		add("offset", task.QueryOffset).
+		add("limit", task.QueryLimit)
+
+	r := []structs.MiningBlock{}
+	err := c.retrieveStruct(reqGetMiningBlocks, uMap{}, filters, &r)
+	return r, err
+}
+
+// EnableMining - enable or disable mining.
+// the availability of mining for the account is checked before enabling
+func (c *UtopiaClient) EnableMining(enabled bool) error {
+	if enabled {
+		info, err := c.GetFinanceInfo()
+		if err != nil {
+			return err
+		}
+		if !info.EnableToUseMining {
+			return ErrorMiningUnavailable
+		}
+	}
+
+	_, err := c.queryResultToBool(reqEnableMining, uMap{"enabled": enabled})
+	return err
+}
@@ -0,0 +1,59 @@
+package utopia
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestGetMiningInfo(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"enabled": true}}`), nil)
+
+	info, err := c.GetMiningInfo()
+	require.NoError(t, err)
+	assert.True(t, info.IsEnabled)
+}
+
+func TestGetMiningBlocks(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": [{"blockId": 1, "reward": 0.5}]}`), nil)
+
+	blocks, err := c.GetMiningBlocks(structs.GetMiningBlocksTask{QueryLimit: 10})
+	require.NoError(t, err)
+	require.Equal(t, 1, len(blocks))
+	assert.Equal(t, 0.5, blocks[0].Reward)
+}
+
+func TestEnableMining(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when mining is not available
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"enableToUseMining": false}}`), nil)
+
+	require.ErrorIs(t, c.EnableMining(true), ErrorMiningUnavailable)
+
+	// when mining is available
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": {"enableToUseMining": true}}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": true}`), nil),
+	)
+	require.NoError(t, c.EnableMining(true))
+
+	// when disable mining
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.EnableMining(false))
+}
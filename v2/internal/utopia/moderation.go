@@ -0,0 +1,87 @@
+package utopia
+
+import (
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+// GetChannelBannedContacts - get channel ban list
+func (c *UtopiaClient) GetChannelBannedContacts(channelID string) ([]structs.ChannelBan, error) {
+	if channelID == "" {
+		return nil, ErrorChannelIDUnset
+	}
+
+	r := []structs.ChannelBan{}
+	if err := c.retrieveStruct(reqGetChannelBannedContacts, uMap{
+		"channelid": channelID,
+	}, uMap{}, &r); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// BanChannelContact - ban channel contact by pubkey hash (ChannelContactData.PubkeyHash)
+func (c *UtopiaClient) BanChannelContact(channelID, pubkeyHash string) error {
+	return c.applyChannelBans(channelID, pubkeyHash, []string{pubkeyHash}, []string{})
+}
+
+// UnbanChannelContact - remove channel contact from the ban list
+func (c *UtopiaClient) UnbanChannelContact(channelID, pubkeyHash string) error {
+	return c.applyChannelBans(channelID, pubkeyHash, []string{}, []string{pubkeyHash})
+}
+
+func (c *UtopiaClient) applyChannelBans(
+	channelID, pubkeyHash string,
+	newList, deletedList []string,
+) error {
+	if channelID == "" {
+		return ErrorChannelIDUnset
+	}
+	if pubkeyHash == "" {
+		return ErrorPubkeyHashUnset
+	}
+
+	_, err := c.queryResultToBool(reqApplyChannelBannedContacts, uMap{
+		"channelid":   channelID,
+		"newList":     newList,
+		"deletedList": deletedList,
+	})
+	return err
+}
+
+// AddChannelModerator - make channel contact a moderator
+func (c *UtopiaClient) AddChannelModerator(channelID, moderatorPubkey string) error {
+	return c.moderatorQuery(reqAddChannelModerator, channelID, moderatorPubkey, uMap{})
+}
+
+// RemoveChannelModerator - revoke moderator status
+func (c *UtopiaClient) RemoveChannelModerator(channelID, moderatorPubkey string) error {
+	return c.moderatorQuery(reqRemoveChannelModerator, channelID, moderatorPubkey, uMap{})
+}
+
+// SetChannelModeratorRights - change moderator rights
+func (c *UtopiaClient) SetChannelModeratorRights(
+	channelID, moderatorPubkey string,
+	rights structs.ModeratorRights,
+) error {
+	return c.moderatorQuery(reqSetChannelModeratorRight, channelID, moderatorPubkey, uMap{
+		"ban":     rights.CanBan,
+		"delete":  rights.CanDeleteMessages,
+		"promote": rights.CanPinMessages,
+	})
+}
+
+func (c *UtopiaClient) moderatorQuery(
+	method, channelID, moderatorPubkey string,
+	params uMap,
+) error {
+	if channelID == "" {
+		return ErrorChannelIDUnset
+	}
+	if moderatorPubkey == "" {
+		return ErrorModeratorUnset
+	}
+
+	params.set("channelid", channelID).set("moderator", moderatorPubkey)
+	_, err := c.queryResultToBool(method, params)
+	return err
+}
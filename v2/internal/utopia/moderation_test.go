@@ -0,0 +1,81 @@
+package utopia
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestGetChannelBannedContacts(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when channel ID is not set
+	_, err := c.GetChannelBannedContacts("")
+	require.ErrorIs(t, err, ErrorChannelIDUnset)
+
+	// when everything is ok
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": [{
+			"hashedPk": "HASH",
+			"nick": "spammer",
+			"banTime": "2022-09-09T05:47:52.972Z"
+		}]}`), nil)
+
+	bans, err := c.GetChannelBannedContacts("channelID")
+	require.NoError(t, err)
+	require.Len(t, bans, 1)
+	assert.Equal(t, "spammer", bans[0].Nick)
+}
+
+func TestBanChannelContact(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when pubkey hash is not set
+	require.ErrorIs(t, c.BanChannelContact("channelID", ""), ErrorPubkeyHashUnset)
+
+	// when everything is ok
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+				q := query{}
+				require.NoError(t, json.Unmarshal(data, &q))
+				assert.Equal(t, []interface{}{"HASH"}, q.Params["newList"])
+				assert.Equal(t, []interface{}{}, q.Params["deletedList"])
+				return []byte(`{"result": true}`), nil
+			}),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+				q := query{}
+				require.NoError(t, json.Unmarshal(data, &q))
+				assert.Equal(t, []interface{}{"HASH"}, q.Params["deletedList"])
+				return []byte(`{"result": true}`), nil
+			}),
+	)
+
+	require.NoError(t, c.BanChannelContact("channelID", "HASH"))
+	require.NoError(t, c.UnbanChannelContact("channelID", "HASH"))
+}
+
+func TestChannelModerators(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when moderator is not set
+	require.ErrorIs(t, c.AddChannelModerator("channelID", ""), ErrorModeratorUnset)
+	require.ErrorIs(t, c.RemoveChannelModerator("", "pubkey"), ErrorChannelIDUnset)
+
+	// when everything is ok
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3).Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.AddChannelModerator("channelID", "pubkey"))
+	require.NoError(t, c.SetChannelModeratorRights("channelID", "pubkey", structs.ModeratorRights{
+		CanBan: true,
+	}))
+	require.NoError(t, c.RemoveChannelModerator("channelID", "pubkey"))
+}
@@ -4,10 +4,9 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"reflect"
 	"strconv"
-	"time"
 
+	uerrors "github.com/Sagleft/utopialib-go/v2/pkg/errors"
 	"gopkg.in/grignaak/tribool.v1"
 )
 
@@ -31,25 +30,13 @@ func (c *UtopiaClient) getWsURL() string {
 	)
 }
 
+// apiQuery2JSON sends the request, its errors are set to the log
 func (c *UtopiaClient) apiQuery2JSON(
+	l *RequestLog,
 	methodName string,
 	params map[string]interface{},
 	filters map[string]interface{},
 ) ([]byte, error) {
-
-	c.limitRate(methodName)
-
-	l := logData{
-		TimeCreated: time.Now(),
-		Timestamp:   time.Now().UnixMilli(),
-		APIURL:      c.getBaseURL(),
-		APIMethod:   methodName,
-		RequestType: "POST",
-		RequestData: params,
-		Filters:     filters,
-	}
-	defer l.handle(c.logCallback)
-
 	var q = query{
 		Method: methodName,
 		Token:  c.data.Token,
@@ -57,19 +44,35 @@ func (c *UtopiaClient) apiQuery2JSON(
 	if params != nil {
 		q.Params = params
 	}
+	if len(filters) > 0 {
+		q.Filters = filters
+	}
 
 	jsonBytes, err := json.Marshal(q)
 	if err != nil {
-		return nil, l.useError(fmt.Errorf("failed to decode response json: %w", err))
+		return nil, l.useError(uerrors.NewDecodeError(
+			methodName,
+			fmt.Errorf("failed to encode request json: %w", err),
+		))
 	}
+	l.RequestSize = len(jsonBytes)
 
-	response, err := c.reqHandler.Send(l.RequestType, l.APIURL, jsonBytes)
+	response, err := c.sendWithRetry(methodName, l.RequestType, l.URL, jsonBytes)
 	if err != nil {
 		return nil, l.useError(err)
 	}
 	return response, nil
 }
 
+// newTransportError wraps the request error.
+// when the client context is done the cause is replaced with *errors.CanceledError
+func (c *UtopiaClient) newTransportError(methodName string, err error) error {
+	if ctxErr := c.ctx.Err(); ctxErr != nil {
+		err = &uerrors.CanceledError{Method: methodName, Err: ctxErr}
+	}
+	return uerrors.NewTransportError(methodName, err)
+}
+
 func (c *UtopiaClient) apiQuery(
 	methodName string,
 	params map[string]interface{},
@@ -84,19 +87,36 @@ func (c *UtopiaClient) apiQueryWithFilters(
 ) (map[string]interface{}, error) {
 	var r map[string]interface{}
 
-	jsonBody, err := c.apiQuery2JSON(methodName, params, filters)
+	// the log is passed to the logger when the response is checked
+	l := newRequestLog(c.getBaseURL(), methodName, params, filters)
+	defer l.handle(c.logger)
+
+	jsonBody, err := c.apiQuery2JSON(l, methodName, params, filters)
 	if err != nil {
 		return r, err
 	}
 
 	if !json.Valid(jsonBody) {
-		return r, errors.New("failed to validate response")
+		return r, l.useError(uerrors.NewDecodeError(
+			methodName,
+			errors.New("failed to validate response"),
+		))
 	}
 
 	if err := json.Unmarshal(jsonBody, &r); err != nil {
-		return r, fmt.Errorf("failed to decode response: %w", err)
+		return r, l.useError(uerrors.NewDecodeError(
+			methodName,
+			fmt.Errorf("failed to decode response: %w", err),
+		))
+	}
+
+	if _, isResultFound := r["result"]; isResultFound {
+		return r, nil
+	}
+	if errorInfoRaw, isErrorFound := r["error"]; isErrorFound {
+		return r, l.useError(uerrors.NewAPIError(methodName, fmt.Sprintf("%v", errorInfoRaw)))
 	}
-	return r, nil
+	return r, l.useError(uerrors.NewDecodeError(methodName, errResultNotFound))
 }
 
 func (c *UtopiaClient) retrieveStruct(
@@ -110,7 +130,7 @@ func (c *UtopiaClient) retrieveStruct(
 		return err
 	}
 
-	return convertResult(response, resultPointer)
+	return convertResult(method, response, resultPointer)
 }
 
 func (c *UtopiaClient) getSimpleStruct(method string, resultPointer interface{}) error {
@@ -122,56 +142,23 @@ func (c *UtopiaClient) queryResultToStringsArray(
 	params map[string]interface{},
 ) ([]string, error) {
 	response, err := c.apiQuery(methodName, params)
-	if result, ok := response["result"]; ok {
-		//check type assertion
-		IResult, isConvertable := result.([]string)
-		if !isConvertable {
-			resultType := reflect.TypeOf(result).String()
-			if resultType == "[]interface {}" {
-				IResult, isConvertable := result.([]interface{})
-				if !isConvertable {
-					return nil, errors.New("failed to get result array. can't convert to strings array")
-				}
-				resultArray := []string{}
-				for _, elementRaw := range IResult {
-					element, isConvertable := elementRaw.(string)
-					if !isConvertable {
-						return nil, errors.New("failed to convert result array element to string")
-					}
-					resultArray = append(resultArray, element)
-				}
-				return resultArray, nil
-			}
-			return nil, errors.New("failed to get result array. []string expected, " + resultType + "given")
-		}
-		return IResult, err
-	}
-	return nil, errors.New("accaptable result doesn't exists in client response")
+	if err != nil {
+		return nil, err
+	}
+
+	result := []string{}
+	if err := convertResult(methodName, response, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
 }
 
 func (c *UtopiaClient) queryResultToString(methodName string, params map[string]interface{}) (string, error) {
 	response, err := c.apiQuery(methodName, params)
 	if err != nil {
-		return "", errors.New("failed to send API request: " + err.Error())
-	}
-	if result, ok := response["result"]; ok {
-		resultstr := fmt.Sprintf("%v", result)
-		return resultstr, err
-	}
-
-	errorInfoRaw, isErrorFound := response["error"]
-	if isErrorFound {
-		errorInfo, isConvertable := errorInfoRaw.(string)
-		if !isConvertable {
-			return "", fmt.Errorf(
-				"parse error (type %q) from result",
-				reflect.ValueOf(errorInfoRaw).String(),
-			)
-		}
-		return "", errors.New(errorInfo)
+		return "", err
 	}
-
-	return "", errors.New("result & error fields doesn't exists in client response")
+	return fmt.Sprintf("%v", response["result"]), nil
 }
 
 func (c *UtopiaClient) queryResultToBool(
@@ -192,7 +179,13 @@ func (c *UtopiaClient) queryResultToFloat64(
 		return 0, err
 	}
 	resultFloat, err := strconv.ParseFloat(resultstr, 64)
-	return resultFloat, err
+	if err != nil {
+		return 0, uerrors.NewDecodeError(
+			methodName,
+			fmt.Errorf("parse query result %q: %w", resultstr, err),
+		)
+	}
+	return resultFloat, nil
 }
 
 func (c *UtopiaClient) queryResultToInt(
@@ -205,7 +198,10 @@ func (c *UtopiaClient) queryResultToInt(
 	}
 	result, err := strconv.ParseInt(resultstr, 10, 64)
 	if err != nil {
-		return 0, fmt.Errorf("parse query result %q: %w", resultstr, err)
+		return 0, uerrors.NewDecodeError(
+			methodName,
+			fmt.Errorf("parse query result %q: %w", resultstr, err),
+		)
 	}
 	return result, nil
 }
@@ -220,27 +216,40 @@ func (c *UtopiaClient) queryResultToUInt(
 	}
 	result, err := strconv.ParseUint(resultstr, 10, 64)
 	if err != nil {
-		return 0, fmt.Errorf("parse query result %q: %w", resultstr, err)
+		return 0, uerrors.NewDecodeError(
+			methodName,
+			fmt.Errorf("parse query result %q: %w", resultstr, err),
+		)
 	}
 	return result, nil
 }
 
-func convertResult(response map[string]interface{}, toInterface interface{}) error {
+func convertResult(
+	methodName string,
+	response map[string]interface{},
+	toInterface interface{},
+) error {
 	// check result exists
 	result, isResultFound := response["result"]
 	if !isResultFound {
-		return errors.New("accaptable result doesn't exists in client response")
+		return uerrors.NewDecodeError(methodName, errResultNotFound)
 	}
 
 	// convert result
 	jsonBytes, err := json.Marshal(result)
 	if err != nil {
-		return fmt.Errorf("failed to encode response result: %w", err)
+		return uerrors.NewDecodeError(
+			methodName,
+			fmt.Errorf("failed to encode response result: %w", err),
+		)
 	}
 
 	err = json.Unmarshal(jsonBytes, toInterface)
 	if err != nil {
-		return fmt.Errorf("failed to decode reconverted result: %w", err)
+		return uerrors.NewDecodeError(
+			methodName,
+			fmt.Errorf("failed to decode reconverted result: %w", err),
+		)
 	}
 	return nil
 }
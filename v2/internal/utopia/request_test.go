@@ -1,12 +1,98 @@
 package utopia
 
 import (
+	"context"
+	"errors"
+	"fmt"
 	"testing"
 
+	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	uerrors "github.com/Sagleft/utopialib-go/v2/pkg/errors"
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
 )
 
 func TestGetWsURL(t *testing.T) {
 	_, c := getTestClient(t)
 	assert.NotEqual(t, "", c.getWsURL())
 }
+
+func TestQueryEncoding(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	gomock.InOrder(
+		// when filters are not set the request is encoded as before
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+				assert.JSONEq(t, fmt.Sprintf(
+					`{"method": %q, "token": %q, "params": {}, "filter": null}`,
+					reqGetBalance, c.data.Token,
+				), string(data))
+				return []byte(`{"result": 5}`), nil
+			}),
+		// when filters are set
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			DoAndReturn(func(_ context.Context, _, _ string, data []byte) ([]byte, error) {
+				assert.JSONEq(t, fmt.Sprintf(
+					`{"method": %q, "token": %q, "params": {}, "filter": {"limitRows": 10}}`,
+					reqGetFinanceHistory, c.data.Token,
+				), string(data))
+				return []byte(`{"result": []}`), nil
+			}),
+	)
+
+	_, err := c.GetBalance()
+	require.NoError(t, err)
+	_, err = c.GetFinanceHistory(structs.GetFinanceHistoryTask{QueryLimitRows: 10})
+	require.NoError(t, err)
+}
+
+func TestAPIErrorTypes(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when client returns an error
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"error": "Not enough funds"}`), nil)
+
+	// then
+	_, err := c.SendPayment(structs.SendPaymentTask{To: "pubkey", Amount: 1})
+	require.ErrorIs(t, err, uerrors.ErrAPI)
+	require.ErrorIs(t, err, uerrors.ErrInsufficientFunds)
+	require.False(t, errors.Is(err, uerrors.ErrContactNotFound))
+
+	var apiErr *uerrors.APIError
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, reqSendPayment, apiErr.Method)
+	assert.Equal(t, "Not enough funds", apiErr.Message)
+
+	// when response can't be decoded
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`invalid json`), nil)
+
+	// then
+	_, err = c.GetBalance()
+	require.ErrorIs(t, err, uerrors.ErrDecode)
+	require.False(t, uerrors.CheckErrorConnBroken(err))
+
+	// when request is not delivered
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, ErrorClientDisconnected)
+
+	// then
+	_, err = c.GetBalance()
+	require.ErrorIs(t, err, uerrors.ErrTransport)
+	require.ErrorIs(t, err, ErrorClientDisconnected)
+	require.True(t, uerrors.CheckErrorConnBroken(err))
+}
+
+func TestGetContactNotFoundCode(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": []}`), nil)
+
+	_, err := c.GetContact("nick")
+	require.ErrorIs(t, err, uerrors.ErrContactNotFound)
+}
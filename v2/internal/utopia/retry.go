@@ -0,0 +1,73 @@
+package utopia
+
+import (
+	"math/rand"
+	"time"
+)
+
+// getAttempts returns the max number of attempts for the API method
+func (p RetryPolicy) getAttempts(methodName string) int {
+	if p.MaxAttempts <= 1 {
+		return 1
+	}
+
+	isRetryable, isSet := p.Methods[methodName]
+	if !isSet {
+		_, isRetryable = readOnlyMethods[methodName]
+	}
+	if !isRetryable {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// getDelay returns exponential backoff delay with jitter before the next attempt
+func (p RetryPolicy) getDelay(attempt int) time.Duration {
+	baseDelay := p.BaseDelay
+	if baseDelay <= 0 {
+		baseDelay = defaultRetryBaseDelay
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultRetryMaxDelay
+	}
+
+	delay := baseDelay
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	// random value in [delay/2, delay]
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
+}
+
+// sendWithRetry sends the request, repeating it according to the retry policy
+// while it fails to be delivered
+func (c *UtopiaClient) sendWithRetry(
+	methodName, reqType, URL string,
+	data []byte,
+) ([]byte, error) {
+	maxAttempts := c.data.Retry.getAttempts(methodName)
+
+	for attempt := 1; ; attempt++ {
+		if err := c.limitRate(methodName); err != nil {
+			return nil, c.newTransportError(methodName, err)
+		}
+
+		response, err := c.reqHandler.Send(c.ctx, reqType, URL, data)
+		if err == nil {
+			return response, nil
+		}
+		if attempt >= maxAttempts || c.ctx.Err() != nil {
+			return nil, c.newTransportError(methodName, err)
+		}
+
+		if err := c.sleep(c.data.Retry.getDelay(attempt)); err != nil {
+			return nil, c.newTransportError(methodName, err)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package utopia
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestRetryPolicyAttempts(t *testing.T) {
+	// when retries are disabled
+	p := RetryPolicy{}
+	// then
+	assert.Equal(t, 1, p.getAttempts(reqGetBalance))
+
+	// when retries are enabled
+	p = RetryPolicy{MaxAttempts: 3}
+	// then
+	assert.Equal(t, 3, p.getAttempts(reqGetBalance))
+	assert.Equal(t, 1, p.getAttempts(reqSendPayment))
+	assert.Equal(t, 1, p.getAttempts(reqCreateVoucher))
+
+	// when methods are overridden
+	p.Methods = map[string]bool{
+		reqSendPayment: true,
+		reqGetBalance:  false,
+	}
+	// then
+	assert.Equal(t, 3, p.getAttempts(reqSendPayment))
+	assert.Equal(t, 1, p.getAttempts(reqGetBalance))
+}
+
+func TestRetryPolicyDelay(t *testing.T) {
+	p := RetryPolicy{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  time.Second,
+	}
+
+	for attempt := 1; attempt < 100; attempt++ {
+		delay := p.getDelay(attempt)
+		assert.LessOrEqual(t, delay, p.MaxDelay)
+		assert.GreaterOrEqual(t, delay, p.BaseDelay/2)
+	}
+}
+
+func TestSendWithRetry(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+	c.data.Retry = RetryPolicy{MaxAttempts: 3, BaseDelay: time.Millisecond}
+
+	// when read-only request fails twice
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Times(2).Return(nil, ErrorClientDisconnected),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": 10}`), nil),
+	)
+
+	// then it's retried
+	balance, err := c.GetBalance()
+	require.NoError(t, err)
+	assert.Equal(t, float64(10), balance)
+
+	// when payment request fails
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(1).Return(nil, ErrorClientDisconnected)
+
+	// then it's not retried
+	_, err = c.SendPayment(structs.SendPaymentTask{To: "pubkey", Amount: 1})
+	require.ErrorIs(t, err, ErrorClientDisconnected)
+}
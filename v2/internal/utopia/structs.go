@@ -1,15 +1,21 @@
 package utopia
 
 import (
+	"context"
+	"time"
+
 	"github.com/Sagleft/utopialib-go/v2/internal/reqhandler"
+	"github.com/Sagleft/utopialib-go/v2/pkg/websocket"
 	"github.com/beefsack/go-rate"
 )
 
 type UtopiaClient struct {
-	reqHandler  reqhandler.RequestHandler
-	data        Config
-	logCallback LogCallback
-	limiters    rateLimiters
+	ctx        context.Context
+	reqHandler reqhandler.RequestHandler
+	data       Config
+	logger     Logger
+	limiters   rateLimiters
+	broker     *websocket.Broker // shared by the event subscribers
 }
 
 type rateLimiters map[string]*rate.RateLimiter
@@ -24,7 +30,25 @@ type Config struct {
 	// optional
 	Protocol              string      `json:"protocol" yaml:"protocol" envconfig:"UTOPIA_PROTO" default:"http"`
 	RequestTimeoutSeconds int         `json:"timeout" yaml:"timeout" envconfig:"UTOPIA_CONN_TIMEOUT" default:"5000"`
-	Cb                    LogCallback `json:"-" yaml:"-"`
+	Logger                Logger      `json:"-" yaml:"-"` // requests log
+	Retry                 RetryPolicy `json:"retry" yaml:"retry"`
+	AvatarsCacheDir       string      `json:"avatarsCacheDir" yaml:"avatarsCacheDir" envconfig:"UTOPIA_AVATARS_CACHE"` // disabled when empty
+
+	// connection settings of the Subscribe shared websocket: reconnect, ping, delivery.
+	// callbacks are ignored. by default: reconnect with the default policy
+	SubscribeOptions *websocket.WsSubscribeTask `json:"-" yaml:"-"`
+}
+
+// RetryPolicy - repeating of requests that failed to be delivered.
+// by default only read-only methods are retried
+type RetryPolicy struct {
+	MaxAttempts int           `json:"maxAttempts" yaml:"maxAttempts"` // 0 or 1 - retries are disabled
+	BaseDelay   time.Duration `json:"baseDelay" yaml:"baseDelay"`     // default: 500ms, doubles on each attempt
+	MaxDelay    time.Duration `json:"maxDelay" yaml:"maxDelay"`       // default: 10s
+
+	// API method name -> whether it can be retried. example: {"sendPayment": true}.
+	// overrides the default read-only methods list
+	Methods map[string]bool `json:"methods" yaml:"methods"`
 }
 
 // query is a filter for API requests
@@ -0,0 +1,125 @@
+package utopia
+
+import (
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func getUNSRecordParams(task structs.UNSRecordTask) uMap {
+	params := uMap{
+		"nick":      task.Name,
+		"isPrimary": task.IsPrimary,
+		"channelId": task.ChannelID,
+	}
+	if !task.ValidTo.IsZero() {
+		params["valid"] = task.ValidTo.Format(unsDateLayout)
+	}
+	return params
+}
+
+// RegisterUNSName - send request to register uNS name
+func (c *UtopiaClient) RegisterUNSName(task structs.UNSRecordTask) error {
+	if task.Name == "" {
+		return ErrorUNSNameUnset
+	}
+
+	if task.MaxFee > 0 {
+		info, err := c.GetFinanceInfo()
+		if err != nil {
+			return err
+		}
+		if info.CRP.GetUNSNameRegistrationFee(task.Name) > task.MaxFee {
+			return ErrorUNSFeeTooHigh
+		}
+	}
+
+	_, err := c.queryResultToString(reqUNSCreateRecordRequest, getUNSRecordParams(task))
+	return err
+}
+
+// ModifyUNSName - send request to modify uNS name record
+func (c *UtopiaClient) ModifyUNSName(task structs.UNSRecordTask) error {
+	if task.Name == "" {
+		return ErrorUNSNameUnset
+	}
+
+	_, err := c.queryResultToString(reqUNSModifyRecordRequest, getUNSRecordParams(task))
+	return err
+}
+
+// DeleteUNSName - send request to delete uNS name
+func (c *UtopiaClient) DeleteUNSName(name string) error {
+	if name == "" {
+		return ErrorUNSNameUnset
+	}
+
+	_, err := c.queryResultToString(reqUNSDeleteRecordRequest, uMap{"nick": name})
+	return err
+}
+
+// SearchUNSByPubkey - find uNS names registered by the pubkey
+func (c *UtopiaClient) SearchUNSByPubkey(pubkey string) ([]structs.UNSRecord, error) {
+	r := []structs.UNSRecord{}
+	err := c.retrieveStruct(reqUNSSearchByPk, uMap{"filter": pubkey}, uMap{}, &r)
+	return r, err
+}
+
+// SearchUNSByName - find uNS names by the name part
+func (c *UtopiaClient) SearchUNSByName(name string) ([]structs.UNSRecord, error) {
+	r := []structs.UNSRecord{}
+	err := c.retrieveStruct(reqUNSSearchByNick, uMap{"name": name}, uMap{}, &r)
+	return r, err
+}
+
+// GetOwnUNSNames - get uNS names registered by the current account
+func (c *UtopiaClient) GetOwnUNSNames() ([]structs.UNSRecord, error) {
+	r := []structs.UNSRecord{}
+	err := c.getSimpleStruct(reqUNSRegisteredNames, &r)
+	return r, err
+}
+
+// RequestUNSTransfer - offer own uNS name to the contact
+func (c *UtopiaClient) RequestUNSTransfer(name, contactPubkeyHash string) error {
+	if name == "" {
+		return ErrorUNSNameUnset
+	}
+
+	_, err := c.queryResultToString(reqRequestUNSTransfer, uMap{
+		"name":     name,
+		"hashedPk": contactPubkeyHash,
+	})
+	return err
+}
+
+// AcceptUNSTransfer - accept incoming uNS name transfer
+func (c *UtopiaClient) AcceptUNSTransfer(requestID string) error {
+	if requestID == "" {
+		return ErrorUNSTransferIDUnset
+	}
+
+	_, err := c.queryResultToString(reqAcceptUNSTransfer, uMap{"requestId": requestID})
+	return err
+}
+
+// DeclineUNSTransfer - decline incoming uNS name transfer
+func (c *UtopiaClient) DeclineUNSTransfer(requestID string) error {
+	if requestID == "" {
+		return ErrorUNSTransferIDUnset
+	}
+
+	_, err := c.queryResultToString(reqDeclineUNSTransfer, uMap{"requestId": requestID})
+	return err
+}
+
+// GetIncomingUNSTransfers - get uNS names offered to the current account
+func (c *UtopiaClient) GetIncomingUNSTransfers() ([]structs.UNSTransfer, error) {
+	r := []structs.UNSTransfer{}
+	err := c.getSimpleStruct(reqIncomingUNSTransfer, &r)
+	return r, err
+}
+
+// GetOutgoingUNSTransfers - get uNS names offered by the current account
+func (c *UtopiaClient) GetOutgoingUNSTransfers() ([]structs.UNSTransfer, error) {
+	r := []structs.UNSTransfer{}
+	err := c.getSimpleStruct(reqOutgoingUNSTransfer, &r)
+	return r, err
+}
@@ -0,0 +1,107 @@
+package utopia
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestRegisterUNSName(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when name is not set
+	require.ErrorIs(t, c.RegisterUNSName(structs.UNSRecordTask{}), ErrorUNSNameUnset)
+
+	// when everything is ok
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.RegisterUNSName(structs.UNSRecordTask{
+		Name:    "mybot",
+		ValidTo: time.Now(),
+	}))
+}
+
+func TestRegisterUNSNameMaxFee(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"CRP": {"unsName1RegistrationFee": 1000}}}`), nil)
+
+	// when name fee is higher than max fee
+	err := c.RegisterUNSName(structs.UNSRecordTask{Name: "a", MaxFee: 10})
+	// then
+	require.ErrorIs(t, err, ErrorUNSFeeTooHigh)
+}
+
+func TestModifyUNSName(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	require.ErrorIs(t, c.ModifyUNSName(structs.UNSRecordTask{}), ErrorUNSNameUnset)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.ModifyUNSName(structs.UNSRecordTask{Name: "mybot"}))
+}
+
+func TestDeleteUNSName(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	require.ErrorIs(t, c.DeleteUNSName(""), ErrorUNSNameUnset)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"error": "name not found"}`), nil)
+
+	require.Error(t, c.DeleteUNSName("mybot"))
+}
+
+func TestSearchUNS(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3).Return([]byte(`{"result": [{"nick": "mybot", "isPrimary": true}]}`), nil)
+
+	records, err := c.SearchUNSByPubkey("pubkey")
+	require.NoError(t, err)
+	require.Equal(t, 1, len(records))
+	assert.Equal(t, "mybot", records[0].Name)
+	assert.True(t, records[0].IsPrimary)
+
+	_, err = c.SearchUNSByName("mybot")
+	require.NoError(t, err)
+
+	_, err = c.GetOwnUNSNames()
+	require.NoError(t, err)
+}
+
+func TestUNSTransfers(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	require.ErrorIs(t, c.RequestUNSTransfer("", "hash"), ErrorUNSNameUnset)
+	require.ErrorIs(t, c.AcceptUNSTransfer(""), ErrorUNSTransferIDUnset)
+	require.ErrorIs(t, c.DeclineUNSTransfer(""), ErrorUNSTransferIDUnset)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(3).Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.RequestUNSTransfer("mybot", "hash"))
+	require.NoError(t, c.AcceptUNSTransfer("1"))
+	require.NoError(t, c.DeclineUNSTransfer("2"))
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).Return([]byte(`{"result": [{"id": "1", "nick": "mybot"}]}`), nil)
+
+	transfers, err := c.GetIncomingUNSTransfers()
+	require.NoError(t, err)
+	require.Equal(t, 1, len(transfers))
+	assert.Equal(t, "mybot", transfers[0].Name)
+
+	_, err = c.GetOutgoingUNSTransfers()
+	require.NoError(t, err)
+}
@@ -0,0 +1,140 @@
+package utopia
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+// GetVouchers - get active vouchers with filters
+func (c *UtopiaClient) GetVouchers(task structs.GetVouchersTask) ([]structs.Voucher, error) {
+	params := uMap{}.
+		add("currency", task.Currency).
+		add("batchId", task.BatchID).
+		add("referenceNumber", task.ReferenceNumber)
+
+	r := []structs.Voucher{}
+	err := c.retrieveStruct(reqGetVouchers, params, uMap{}, &r)
+	return r, err
+}
+
+// GetVoucherBatch - get vouchers created by the request with the reference number
+func (c *UtopiaClient) GetVoucherBatch(referenceNumber string) ([]structs.Voucher, error) {
+	return c.GetVouchers(structs.GetVouchersTask{ReferenceNumber: referenceNumber})
+}
+
+// DeleteVoucher - delete unused voucher. voucher amount is returned to the account
+func (c *UtopiaClient) DeleteVoucher(voucherID string) error {
+	if voucherID == "" {
+		return ErrorVoucherIDUnset
+	}
+
+	_, err := c.queryResultToString(reqDeleteVoucher, uMap{"voucherid": voucherID})
+	return err
+}
+
+func getVouchersLimits(info structs.FinanceInfo, coin string) (maxActive, maxPerBatch uint) {
+	if coin == coinUUSD {
+		return info.UUSD.VouchersMaxActive, info.UUSD.VouchersMaxPerBatch
+	}
+	return info.CRP.VouchersMaxActive, info.CRP.VouchersMaxPerBatch
+}
+
+// CheckVouchersLimits - check that count vouchers of the currency ("CRP" or "UUSD")
+// can be created without exceeding the finance limits. it's not called by the voucher
+// create methods: the check costs two requests and the limits can change before the creation
+func (c *UtopiaClient) CheckVouchersLimits(currency string, count int) error {
+	if currency != coinCRP && currency != coinUUSD {
+		return ErrorUnknownCurrency
+	}
+
+	info, err := c.GetFinanceInfo()
+	if err != nil {
+		return err
+	}
+
+	maxActive, maxPerBatch := getVouchersLimits(info, currency)
+	if maxPerBatch > 0 && uint(count) > maxPerBatch {
+		return ErrorVouchersMaxBatch
+	}
+	if maxActive == 0 {
+		return nil
+	}
+
+	vouchers, err := c.GetVouchers(structs.GetVouchersTask{Currency: currency})
+	if err != nil {
+		return err
+	}
+	if uint(len(vouchers)+count) > maxActive {
+		return ErrorVouchersMaxActive
+	}
+	return nil
+}
+
+// CreateVoucherBatchAndWait - create vouchers batch & wait until all batch vouchers are created.
+// on timeout ErrorVoucherBatchWait is returned with the vouchers created so far
+func (c *UtopiaClient) CreateVoucherBatchAndWait(
+	task structs.CreateVoucherBatchTask,
+) (structs.VoucherBatch, error) {
+	if task.Count <= 0 {
+		task.Count = 1
+	}
+	if task.Currency == "" {
+		task.Currency = coinCRP
+	}
+	if task.Currency != coinCRP && task.Currency != coinUUSD {
+		return structs.VoucherBatch{}, ErrorUnknownCurrency
+	}
+	if task.Timeout <= 0 {
+		task.Timeout = defaultVoucherBatchTimeout
+	}
+	if task.PollInterval <= 0 {
+		task.PollInterval = defaultVoucherBatchPoll
+	}
+
+	if task.CheckLimits {
+		if err := c.CheckVouchersLimits(task.Currency, task.Count); err != nil {
+			return structs.VoucherBatch{}, err
+		}
+	}
+
+	referenceNumber, err := c.createCoinVoucher(task.Amount, task.Currency, task.Count)
+	if err != nil {
+		return structs.VoucherBatch{}, err
+	}
+
+	batch := structs.VoucherBatch{
+		ReferenceNumber: referenceNumber,
+		Currency:        task.Currency,
+	}
+
+	ctx, cancel := context.WithTimeout(c.ctx, task.Timeout)
+	defer cancel()
+	waitClient := c.WithContext(ctx)
+
+	for {
+		if err := waitClient.sleep(task.PollInterval); err != nil {
+			return batch, c.getBatchWaitError(err)
+		}
+
+		vouchers, err := waitClient.GetVoucherBatch(referenceNumber)
+		if err != nil {
+			return batch, c.getBatchWaitError(err)
+		}
+
+		batch.Vouchers = vouchers
+		if len(vouchers) >= task.Count {
+			return batch, nil
+		}
+	}
+}
+
+// getBatchWaitError replaces the error when the batch waiting timeout expired
+// while the client context is still active
+func (c *UtopiaClient) getBatchWaitError(err error) error {
+	if c.ctx.Err() == nil && errors.Is(err, context.DeadlineExceeded) {
+		return ErrorVoucherBatchWait
+	}
+	return err
+}
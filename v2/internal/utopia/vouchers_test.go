@@ -0,0 +1,122 @@
+package utopia
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestGetVouchers(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Times(2).Return([]byte(`{"result": [
+			{"voucherid": "CRP-1", "amount": 5, "referenceNumber": "REF"},
+			{"voucherid": "CRP-2", "amount": 5, "referenceNumber": "REF"}
+		]}`), nil)
+
+	vouchers, err := c.GetVouchers(structs.GetVouchersTask{Currency: coinCRP, BatchID: 1})
+	require.NoError(t, err)
+	assert.Equal(t, 2, len(vouchers))
+
+	vouchers, err = c.GetVoucherBatch("REF")
+	require.NoError(t, err)
+	require.Equal(t, 2, len(vouchers))
+	assert.Equal(t, "CRP-1", vouchers[0].ID)
+}
+
+func TestDeleteVoucher(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	require.ErrorIs(t, c.DeleteVoucher(""), ErrorVoucherIDUnset)
+
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": true}`), nil)
+
+	require.NoError(t, c.DeleteVoucher("CRP-1"))
+}
+
+func TestCheckVouchersLimits(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when currency is unknown
+	require.ErrorIs(t, c.CheckVouchersLimits("BTC", 1), ErrorUnknownCurrency)
+
+	// when vouchers count is more than max per batch
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"CRP": {"vouchersMaxPerBatch": 5}}}`), nil)
+
+	require.ErrorIs(t, c.CheckVouchersLimits(coinCRP, 10), ErrorVouchersMaxBatch)
+
+	// when max active vouchers count reached
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"USD": {"vouchersMaxActive": 2}}}`), nil)
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": [{"voucherid": "1"}, {"voucherid": "2"}]}`), nil)
+
+	require.ErrorIs(t, c.CheckVouchersLimits(coinUUSD, 1), ErrorVouchersMaxActive)
+
+	// when limits are checked before the batch creation
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": {"CRP": {"vouchersMaxPerBatch": 5}}}`), nil)
+
+	_, err := c.CreateVoucherBatchAndWait(structs.CreateVoucherBatchTask{
+		Amount:      1,
+		Count:       10,
+		CheckLimits: true,
+	})
+	require.ErrorIs(t, err, ErrorVouchersMaxBatch)
+}
+
+func TestCreateVoucherBatchAndWait(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": "REF"}`), nil),
+		// batch is not ready yet
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": [{"voucherid": "CRP-1", "amount": 5}]}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": [
+				{"voucherid": "CRP-1", "amount": 5},
+				{"voucherid": "CRP-2", "amount": 5}
+			]}`), nil),
+	)
+
+	batch, err := c.CreateVoucherBatchAndWait(structs.CreateVoucherBatchTask{
+		Amount:       5,
+		Count:        2,
+		PollInterval: time.Millisecond,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "REF", batch.ReferenceNumber)
+	assert.Equal(t, []string{"CRP-1", "CRP-2"}, batch.Codes())
+	assert.Equal(t, float64(10), batch.TotalAmount())
+}
+
+func TestCreateVoucherBatchAndWaitTimeout(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when currency is unknown
+	_, err := c.CreateVoucherBatchAndWait(structs.CreateVoucherBatchTask{Currency: "BTC"})
+	require.ErrorIs(t, err, ErrorUnknownCurrency)
+
+	// when batch is not created in time
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": "REF"}`), nil)
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		AnyTimes().Return([]byte(`{"result": []}`), nil)
+
+	_, err = c.CreateVoucherBatchAndWait(structs.CreateVoucherBatchTask{
+		Amount:       5,
+		Timeout:      20 * time.Millisecond,
+		PollInterval: time.Millisecond,
+	})
+	require.ErrorIs(t, err, ErrorVoucherBatchWait)
+}
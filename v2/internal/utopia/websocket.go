@@ -1,48 +1,85 @@
 package utopia
 
 import (
-	"encoding/json"
-	"errors"
+	"context"
 
 	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
 	"github.com/Sagleft/utopialib-go/v2/pkg/websocket"
 )
 
-// WsSubscribe - connect to websocket & receive messages.
-// NOTE: it's blocking method
+// WsSubscribe - connect to websocket & receive messages in the callbacks
 func (c *UtopiaClient) WsSubscribe(task websocket.WsSubscribeTask) (websocket.Handler, error) {
-	h := websocket.NewWsHandler(c.getWsURL(), task)
+	h := websocket.NewWsHandler(c.getWsURL(), c.withWsStateRestorer(task))
 	return h, h.Connect()
 }
 
+// Subscribe - receive websocket events matching the filter until ctx is done.
+// all subscribers share a single connection, its settings are taken from Config.SubscribeOptions
+func (c *UtopiaClient) Subscribe(
+	ctx context.Context,
+	filter websocket.EventFilter,
+) (<-chan websocket.WsEvent, <-chan error) {
+	return c.broker.Subscribe(ctx, filter)
+}
+
+// getSubscribeTask returns the shared Subscribe connection settings
+func (c *UtopiaClient) getSubscribeTask() websocket.WsSubscribeTask {
+	task := websocket.WsSubscribeTask{
+		Reconnect: &websocket.ReconnectPolicy{},
+	}
+	if c.data.SubscribeOptions != nil {
+		task = *c.data.SubscribeOptions
+	}
+	return c.withWsStateRestorer(task)
+}
+
+// withWsStateRestorer sets the websocket state restoring before reconnect
+// when it's required by the task reconnect policy
+func (c *UtopiaClient) withWsStateRestorer(task websocket.WsSubscribeTask) websocket.WsSubscribeTask {
+	if task.Reconnect != nil && task.Reconnect.RestoreState != nil {
+		policy := *task.Reconnect
+		policy.BeforeDial = c.getWsStateRestorer(*policy.RestoreState, policy.BeforeDial)
+		task.Reconnect = &policy
+	}
+	return task
+}
+
+// getWsStateRestorer enables websocket notifications before reconnect
+// when they were disabled by the client restart
+func (c *UtopiaClient) getWsStateRestorer(
+	state structs.SetWsStateTask,
+	beforeDial func() error,
+) func() error {
+	return func() error {
+		if beforeDial != nil {
+			if err := beforeDial(); err != nil {
+				return err
+			}
+		}
+
+		port, err := c.GetWebSocketState()
+		if err != nil {
+			return err
+		}
+		if port != 0 {
+			return nil
+		}
+		return c.SetWebSocketState(state)
+	}
+}
+
 // ParseWsChannelMessage - get the event data converted to ChannelMessage.
 // actual only for `newPrivateChannelMessage` and `newChannelMessage` events
 func ParseWsChannelMessage(e *websocket.WsEvent) (structs.WsChannelMessage, error) {
 	result := structs.WsChannelMessage{}
-	eventBytes, err := json.Marshal(e.Data)
-	if err != nil {
-		return result, errors.New("failed to encode channel message: " + err.Error())
-	}
-
-	err = json.Unmarshal(eventBytes, &result)
-	if err != nil {
-		return result, errors.New("failed to decode event data as channel message: " + err.Error())
-	}
-	return result, nil
+	err := e.Decode(&result)
+	return result, err
 }
 
 // ParseWsInstantMessage - get the event data converted to InstantMessage.
 // actual only for `newInstantMessage` event
 func ParseWsInstantMessage(e *websocket.WsEvent) (structs.InstantMessage, error) {
 	result := structs.InstantMessage{}
-	eventBytes, err := json.Marshal(e.Data)
-	if err != nil {
-		return result, errors.New("failed to encode contact message: " + err.Error())
-	}
-
-	err = json.Unmarshal(eventBytes, &result)
-	if err != nil {
-		return result, errors.New("failed to decode event data as contact message: " + err.Error())
-	}
-	return result, nil
+	err := e.Decode(&result)
+	return result, err
 }
@@ -0,0 +1,74 @@
+package utopia
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+	"github.com/Sagleft/utopialib-go/v2/pkg/websocket"
+)
+
+func TestWsStateRestorer(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+	restore := c.getWsStateRestorer(structs.SetWsStateTask{
+		Enabled:       true,
+		Port:          25000,
+		Notifications: "all",
+	}, nil)
+
+	// when notifications are enabled
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": 25000}`), nil)
+
+	require.NoError(t, restore())
+
+	// when notifications were disabled by the client restart
+	gomock.InOrder(
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": 0}`), nil),
+		handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+			Return([]byte(`{"result": "25000"}`), nil),
+	)
+
+	require.NoError(t, restore())
+}
+
+func TestGetSubscribeTask(t *testing.T) {
+	handlerMock, c := getTestClient(t)
+
+	// when options are not set
+	task := c.getSubscribeTask()
+	require.NotNil(t, task.Reconnect)
+	assert.Nil(t, task.Reconnect.BeforeDial)
+
+	// when options are set
+	options := websocket.WsSubscribeTask{
+		DisablePing: true,
+		Reconnect: &websocket.ReconnectPolicy{
+			MaxAttempts:  3,
+			BaseDelay:    time.Second,
+			RestoreState: &structs.SetWsStateTask{Enabled: true, Notifications: "all"},
+		},
+		Delivery: &websocket.DeliveryPolicy{Workers: 2},
+	}
+	c.data.SubscribeOptions = &options
+
+	task = c.getSubscribeTask()
+	assert.True(t, task.DisablePing)
+	assert.Equal(t, 3, task.Reconnect.MaxAttempts)
+	assert.Equal(t, time.Second, task.Reconnect.BaseDelay)
+	assert.Equal(t, 2, task.Delivery.Workers)
+	// caller's options are not changed
+	assert.Nil(t, options.Reconnect.BeforeDial)
+
+	// state is restored before reconnect
+	handlerMock.EXPECT().Send(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return([]byte(`{"result": 25000}`), nil)
+
+	require.NotNil(t, task.Reconnect.BeforeDial)
+	require.NoError(t, task.Reconnect.BeforeDial())
+}
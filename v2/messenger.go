@@ -1,12 +1,21 @@
 package utopiago
 
 import (
+	"context"
+	"io"
+
 	"github.com/Sagleft/utopialib-go/v2/internal/utopia"
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
 	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
 	"github.com/Sagleft/utopialib-go/v2/pkg/websocket"
 )
 
 type Client interface {
+	// WithContext returns a client whose requests are bound to ctx:
+	// rate limiter waits and in-flight requests are interrupted when ctx is done,
+	// in that case methods return *errors.CanceledError
+	WithContext(ctx context.Context) Client
+
 	// GetProfileStatus gets data about the status of the current account
 	GetProfileStatus() (structs.ProfileStatus, error)
 
@@ -40,7 +49,8 @@ type Client interface {
 	// GetUUSDBalance request account UUSD balance
 	GetUUSDBalance() (float64, error)
 
-	// CreateVoucher requests the creation of a new Crypton voucher. it returns referenceNumber
+	// CreateVoucher requests the creation of a new Crypton voucher. it returns referenceNumber.
+	// vouchers limits are not checked, use CheckVouchersLimits before sending
 	CreateVoucher(amount float64) (string, error)
 	CreateVoucherBatch(amount float64, count int) (string, error)
 
@@ -55,8 +65,7 @@ type Client interface {
 	// SetWebSocketState - set WSS Notification state
 	SetWebSocketState(task structs.SetWsStateTask) error
 
-	// WsSubscribe - connect to websocket & receive messages.
-	// NOTE: it's blocking method
+	// WsSubscribe - connect to websocket & receive messages in the callbacks
 	WsSubscribe(task websocket.WsSubscribeTask) (websocket.Handler, error)
 
 	// SendChannelMessage - send channel message & get message ID
@@ -150,10 +159,257 @@ type Client interface {
 
 	// GetSyncProgress() - get sync progress percent. example: 98.99
 	GetSyncProgress() (float64, error)
+
+	// RegisterUNSName - send request to register uNS name.
+	// set task.MaxFee to check the name registration fee before sending
+	RegisterUNSName(task structs.UNSRecordTask) error
+
+	// ModifyUNSName - send request to modify uNS name record
+	ModifyUNSName(task structs.UNSRecordTask) error
+
+	// DeleteUNSName - send request to delete uNS name
+	DeleteUNSName(name string) error
+
+	// SearchUNSByPubkey - find uNS names registered by the pubkey
+	SearchUNSByPubkey(pubkey string) ([]structs.UNSRecord, error)
+
+	// SearchUNSByName - find uNS names by the name part
+	SearchUNSByName(name string) ([]structs.UNSRecord, error)
+
+	// GetOwnUNSNames - get uNS names registered by the current account
+	GetOwnUNSNames() ([]structs.UNSRecord, error)
+
+	// RequestUNSTransfer - offer own uNS name to the contact
+	RequestUNSTransfer(name, contactPubkeyHash string) error
+
+	// AcceptUNSTransfer - accept incoming uNS name transfer
+	AcceptUNSTransfer(requestID string) error
+
+	// DeclineUNSTransfer - decline incoming uNS name transfer
+	DeclineUNSTransfer(requestID string) error
+
+	// GetIncomingUNSTransfers - get uNS names offered to the current account
+	GetIncomingUNSTransfers() ([]structs.UNSTransfer, error)
+
+	// GetOutgoingUNSTransfers - get uNS names offered by the current account
+	GetOutgoingUNSTransfers() ([]structs.UNSTransfer, error)
+
+	// CreateCard - create crypto card & get its ID.
+	// cards creation state, max active & max per day limits are checked before sending
+	CreateCard(task structs.CreateCardTask) (string, error)
+
+	// GetCards - get own crypto cards with balances
+	GetCards() ([]structs.Card, error)
+
+	// DeleteCard - delete crypto card
+	DeleteCard(cardID string) error
+
+	// RenameCard - change crypto card name
+	RenameCard(cardID, name string) error
+
+	// SetCardColor - change crypto card color. example: #FBEDC0
+	SetCardColor(cardID, color string) error
+
+	// SendInvoice - issue invoice & get its reference number
+	SendInvoice(task structs.SendInvoiceTask) (string, error)
+
+	// GetInvoices - get incoming & outgoing invoices with filters
+	GetInvoices(task structs.GetInvoicesTask) ([]structs.Invoice, error)
+
+	// GetInvoiceByReferenceNumber - find invoice by reference number
+	GetInvoiceByReferenceNumber(referenceNumber string) (structs.Invoice, error)
+
+	// AcceptInvoice - pay incoming invoice
+	AcceptInvoice(invoiceID string) error
+
+	// DeclineInvoice - decline incoming invoice
+	DeclineInvoice(invoiceID string) error
+
+	// CancelInvoice - cancel own outgoing invoice
+	CancelInvoice(invoiceID string) error
+
+	// GetFinanceHistoryIterator - iterate over the finance history page by page.
+	// each page request waits for the rate limiter
+	GetFinanceHistoryIterator(task structs.FinanceHistoryIteratorTask) *FinanceHistoryIterator
+
+	// GetVouchers - get active vouchers with filters
+	GetVouchers(task structs.GetVouchersTask) ([]structs.Voucher, error)
+
+	// GetVoucherBatch - get vouchers created by the request with the reference number
+	GetVoucherBatch(referenceNumber string) ([]structs.Voucher, error)
+
+	// DeleteVoucher - delete unused voucher
+	DeleteVoucher(voucherID string) error
+
+	// CreateVoucherBatchAndWait - create vouchers batch & wait until all batch vouchers are created.
+	// returns batch with voucher codes
+	CreateVoucherBatchAndWait(task structs.CreateVoucherBatchTask) (structs.VoucherBatch, error)
+
+	// GetMiningInfo - get mining state
+	GetMiningInfo() (structs.MiningInfo, error)
+
+	// GetMiningBlocks - get mined blocks with rewards
+	GetMiningBlocks(task structs.GetMiningBlocksTask) ([]structs.MiningBlock, error)
+
+	// EnableMining - enable or disable mining
+	EnableMining(enabled bool) error
+
+	// GetEmailFolder - get IDs of the emails in the folder.
+	// all folders are listed in consts.EmailFolders
+	GetEmailFolder(folder consts.EmailFolder, filter string) ([]uint64, error)
+
+	// GetEmails - get the emails in the folder
+	GetEmails(folder consts.EmailFolder, filter string) ([]structs.Email, error)
+
+	// GetEmail - get email by ID
+	GetEmail(emailID uint64) (structs.Email, error)
+
+	// SendEmail - send uMail message
+	SendEmail(task structs.SendEmailTask) error
+
+	// ReplyEmail - send reply to the email
+	ReplyEmail(emailID uint64, subject, body string) error
+
+	// ForwardEmail - forward the email to recipients
+	ForwardEmail(emailID uint64, task structs.SendEmailTask) error
+
+	// DeleteEmail - move the email to trash or delete it when it's already in trash
+	DeleteEmail(emailID uint64) error
+
+	// GetContactMessages - get messages history with the contact (offset, max messages count)
+	GetContactMessages(pubkey string, offset int, limit int) ([]structs.InstantMessage, error)
+
+	// GetContactMessagesIterator - iterate over messages history with the contact page by page
+	GetContactMessagesIterator(pubkey string, pageSize int) *ContactMessagesIterator
+
+	// RemoveContact - remove user from contacts
+	RemoveContact(pubkey string) error
+
+	// SetContactNick - set local nickname for the contact
+	SetContactNick(pubkey, nick string) error
+
+	// SetContactGroup - move the contact to the group
+	SetContactGroup(pubkey, groupName string) error
+
+	// BlockContact - add the contact to the black list
+	BlockContact(pubkey string) error
+
+	// UnblockContact - remove the contact from the black list
+	UnblockContact(pubkey string) error
+
+	// GetContactGroups - get contact group names
+	GetContactGroups() ([]string, error)
+
+	// GetContactsByGroup - get the contacts in the group
+	GetContactsByGroup(groupName string) ([]structs.ContactData, error)
+
+	// RenameContactGroup - change contact group name
+	RenameContactGroup(oldName, newName string) error
+
+	// DeleteContactGroup - delete contact group
+	DeleteContactGroup(groupName string) error
+
+	// GetContactAvatar - download contact avatar.
+	// avatarHash is optional (ContactData.AvatarHash), it's used as the cache key
+	// when Config.AvatarsCacheDir is set
+	GetContactAvatar(pubkey, avatarHash string) (structs.Avatar, error)
+
+	// GetChannelAvatar - download channel avatar.
+	// avatarID is optional (SearchChannelData.AvatarID), it's used as the cache key
+	GetChannelAvatar(channelID, avatarID string) (structs.Avatar, error)
+
+	// GetOwnAvatar - download own account avatar
+	GetOwnAvatar() (structs.Avatar, error)
+
+	// SetProfileAvatar - update own account avatar. PNG or JPG image expected
+	SetProfileAvatar(image io.Reader) error
+
+	// CreateChannel - create channel & get its ID
+	CreateChannel(task structs.CreateChannelTask) (string, error)
+
+	// ModifyChannel - update channel settings. only set fields of the task are sent,
+	// other settings stay unchanged
+	ModifyChannel(channelID string, task structs.ModifyChannelTask) error
+
+	// DeleteChannel - delete own channel. password is required for a private channel
+	DeleteChannel(channelID string, password ...string) error
+
+	// LeaveChannel - leave joined channel
+	LeaveChannel(channelID string) error
+
+	// GetChannelBannedContacts - get channel ban list
+	GetChannelBannedContacts(channelID string) ([]structs.ChannelBan, error)
+
+	// BanChannelContact - ban channel contact by pubkey hash (ChannelContactData.PubkeyHash)
+	BanChannelContact(channelID, pubkeyHash string) error
+
+	// UnbanChannelContact - remove channel contact from the ban list
+	UnbanChannelContact(channelID, pubkeyHash string) error
+
+	// AddChannelModerator - make channel contact a moderator
+	AddChannelModerator(channelID, moderatorPubkey string) error
+
+	// RemoveChannelModerator - revoke moderator status
+	RemoveChannelModerator(channelID, moderatorPubkey string) error
+
+	// SetChannelModeratorRights - change moderator rights
+	SetChannelModeratorRights(channelID, moderatorPubkey string, rights structs.ModeratorRights) error
+
+	// ReplyChannelMessage - send channel message in reply to the message & get new message ID
+	ReplyChannelMessage(channelID string, messageID uint64, message string) (string, error)
+
+	// QuoteChannelMessage - send channel message quoting the message & get new message ID
+	QuoteChannelMessage(channelID string, messageID uint64, message string) (string, error)
+
+	// EditChannelMessage - change text of the own channel message
+	EditChannelMessage(channelID string, messageID uint64, message string) error
+
+	// PinChannelMessage - pin channel message
+	PinChannelMessage(channelID string, messageID uint64) error
+
+	// UnpinChannelMessage - unpin channel message
+	UnpinChannelMessage(channelID string, messageID uint64) error
+
+	// GetChannelHistoryIterator - iterate over the channel messages backward or forward
+	// by message ID cursor, so new messages don't shift the pages
+	GetChannelHistoryIterator(task structs.ChannelHistoryIteratorTask) *ChannelHistoryIterator
+
+	// Subscribe - receive websocket events matching the filter until ctx is done.
+	// all subscribers share a single connection, its settings are taken from Config.SubscribeOptions
+	Subscribe(ctx context.Context, filter websocket.EventFilter) (<-chan websocket.WsEvent, <-chan error)
+
+	// CheckVouchersLimits - check that count vouchers of the currency ("CRP" or "UUSD")
+	// can be created without exceeding the finance limits
+	CheckVouchersLimits(currency string, count int) error
 }
 
 type Config = utopia.Config
 
+type RetryPolicy = utopia.RetryPolicy
+
+type Logger = utopia.Logger
+
+type LogFunc = utopia.LogFunc
+
+type RequestLog = utopia.RequestLog
+
+// FinanceHistoryIterator - finance history pages iterator
+type FinanceHistoryIterator = utopia.Iterator[structs.FinanceHistoryData]
+
+// ContactMessagesIterator - contact messages history pages iterator
+type ContactMessagesIterator = utopia.Iterator[structs.InstantMessage]
+
+// ChannelHistoryIterator - channel messages iterator
+type ChannelHistoryIterator = utopia.Iterator[structs.ChannelMessage]
+
 func NewUtopiaClient(c Config) Client {
-	return utopia.NewUtopiaClient(c)
+	return client{utopia.NewUtopiaClient(c)}
+}
+
+type client struct {
+	*utopia.UtopiaClient
+}
+
+func (c client) WithContext(ctx context.Context) Client {
+	return client{c.UtopiaClient.WithContext(ctx)}
 }
@@ -5,8 +5,12 @@
 package bots
 
 import (
+	context "context"
+	io "io"
 	reflect "reflect"
 
+	v2 "github.com/Sagleft/utopialib-go/v2"
+	consts "github.com/Sagleft/utopialib-go/v2/pkg/consts"
 	structs "github.com/Sagleft/utopialib-go/v2/pkg/structs"
 	websocket "github.com/Sagleft/utopialib-go/v2/pkg/websocket"
 	gomock "github.com/golang/mock/gomock"
@@ -50,6 +54,90 @@ func (mr *MockClientMockRecorder) AcceptAuthRequest(pubkey, message interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptAuthRequest", reflect.TypeOf((*MockClient)(nil).AcceptAuthRequest), pubkey, message)
 }
 
+// AcceptInvoice mocks base method.
+func (m *MockClient) AcceptInvoice(invoiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptInvoice", invoiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptInvoice indicates an expected call of AcceptInvoice.
+func (mr *MockClientMockRecorder) AcceptInvoice(invoiceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptInvoice", reflect.TypeOf((*MockClient)(nil).AcceptInvoice), invoiceID)
+}
+
+// AcceptUNSTransfer mocks base method.
+func (m *MockClient) AcceptUNSTransfer(requestID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcceptUNSTransfer", requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AcceptUNSTransfer indicates an expected call of AcceptUNSTransfer.
+func (mr *MockClientMockRecorder) AcceptUNSTransfer(requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcceptUNSTransfer", reflect.TypeOf((*MockClient)(nil).AcceptUNSTransfer), requestID)
+}
+
+// AddChannelModerator mocks base method.
+func (m *MockClient) AddChannelModerator(channelID, moderatorPubkey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddChannelModerator", channelID, moderatorPubkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddChannelModerator indicates an expected call of AddChannelModerator.
+func (mr *MockClientMockRecorder) AddChannelModerator(channelID, moderatorPubkey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddChannelModerator", reflect.TypeOf((*MockClient)(nil).AddChannelModerator), channelID, moderatorPubkey)
+}
+
+// BanChannelContact mocks base method.
+func (m *MockClient) BanChannelContact(channelID, pubkeyHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BanChannelContact", channelID, pubkeyHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BanChannelContact indicates an expected call of BanChannelContact.
+func (mr *MockClientMockRecorder) BanChannelContact(channelID, pubkeyHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BanChannelContact", reflect.TypeOf((*MockClient)(nil).BanChannelContact), channelID, pubkeyHash)
+}
+
+// BlockContact mocks base method.
+func (m *MockClient) BlockContact(pubkey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockContact", pubkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockContact indicates an expected call of BlockContact.
+func (mr *MockClientMockRecorder) BlockContact(pubkey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockContact", reflect.TypeOf((*MockClient)(nil).BlockContact), pubkey)
+}
+
+// CancelInvoice mocks base method.
+func (m *MockClient) CancelInvoice(invoiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelInvoice", invoiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CancelInvoice indicates an expected call of CancelInvoice.
+func (mr *MockClientMockRecorder) CancelInvoice(invoiceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelInvoice", reflect.TypeOf((*MockClient)(nil).CancelInvoice), invoiceID)
+}
+
 // CheckClientConnection mocks base method.
 func (m *MockClient) CheckClientConnection() bool {
 	m.ctrl.T.Helper()
@@ -64,6 +152,50 @@ func (mr *MockClientMockRecorder) CheckClientConnection() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckClientConnection", reflect.TypeOf((*MockClient)(nil).CheckClientConnection))
 }
 
+// CheckVouchersLimits mocks base method.
+func (m *MockClient) CheckVouchersLimits(currency string, count int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckVouchersLimits", currency, count)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CheckVouchersLimits indicates an expected call of CheckVouchersLimits.
+func (mr *MockClientMockRecorder) CheckVouchersLimits(currency, count interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVouchersLimits", reflect.TypeOf((*MockClient)(nil).CheckVouchersLimits), currency, count)
+}
+
+// CreateCard mocks base method.
+func (m *MockClient) CreateCard(task structs.CreateCardTask) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCard", task)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCard indicates an expected call of CreateCard.
+func (mr *MockClientMockRecorder) CreateCard(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCard", reflect.TypeOf((*MockClient)(nil).CreateCard), task)
+}
+
+// CreateChannel mocks base method.
+func (m *MockClient) CreateChannel(task structs.CreateChannelTask) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateChannel", task)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateChannel indicates an expected call of CreateChannel.
+func (mr *MockClientMockRecorder) CreateChannel(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateChannel", reflect.TypeOf((*MockClient)(nil).CreateChannel), task)
+}
+
 // CreateUUSDVoucher mocks base method.
 func (m *MockClient) CreateUUSDVoucher(amount float64) (string, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +256,152 @@ func (mr *MockClientMockRecorder) CreateVoucherBatch(amount, count interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVoucherBatch", reflect.TypeOf((*MockClient)(nil).CreateVoucherBatch), amount, count)
 }
 
+// CreateVoucherBatchAndWait mocks base method.
+func (m *MockClient) CreateVoucherBatchAndWait(task structs.CreateVoucherBatchTask) (structs.VoucherBatch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVoucherBatchAndWait", task)
+	ret0, _ := ret[0].(structs.VoucherBatch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVoucherBatchAndWait indicates an expected call of CreateVoucherBatchAndWait.
+func (mr *MockClientMockRecorder) CreateVoucherBatchAndWait(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVoucherBatchAndWait", reflect.TypeOf((*MockClient)(nil).CreateVoucherBatchAndWait), task)
+}
+
+// DeclineInvoice mocks base method.
+func (m *MockClient) DeclineInvoice(invoiceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineInvoice", invoiceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineInvoice indicates an expected call of DeclineInvoice.
+func (mr *MockClientMockRecorder) DeclineInvoice(invoiceID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineInvoice", reflect.TypeOf((*MockClient)(nil).DeclineInvoice), invoiceID)
+}
+
+// DeclineUNSTransfer mocks base method.
+func (m *MockClient) DeclineUNSTransfer(requestID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclineUNSTransfer", requestID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeclineUNSTransfer indicates an expected call of DeclineUNSTransfer.
+func (mr *MockClientMockRecorder) DeclineUNSTransfer(requestID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclineUNSTransfer", reflect.TypeOf((*MockClient)(nil).DeclineUNSTransfer), requestID)
+}
+
+// DeleteCard mocks base method.
+func (m *MockClient) DeleteCard(cardID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCard", cardID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteCard indicates an expected call of DeleteCard.
+func (mr *MockClientMockRecorder) DeleteCard(cardID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCard", reflect.TypeOf((*MockClient)(nil).DeleteCard), cardID)
+}
+
+// DeleteChannel mocks base method.
+func (m *MockClient) DeleteChannel(channelID string, password ...string) error {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{channelID}
+	for _, a := range password {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DeleteChannel", varargs...)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteChannel indicates an expected call of DeleteChannel.
+func (mr *MockClientMockRecorder) DeleteChannel(channelID interface{}, password ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{channelID}, password...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteChannel", reflect.TypeOf((*MockClient)(nil).DeleteChannel), varargs...)
+}
+
+// DeleteContactGroup mocks base method.
+func (m *MockClient) DeleteContactGroup(groupName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteContactGroup", groupName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteContactGroup indicates an expected call of DeleteContactGroup.
+func (mr *MockClientMockRecorder) DeleteContactGroup(groupName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteContactGroup", reflect.TypeOf((*MockClient)(nil).DeleteContactGroup), groupName)
+}
+
+// DeleteEmail mocks base method.
+func (m *MockClient) DeleteEmail(emailID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEmail", emailID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteEmail indicates an expected call of DeleteEmail.
+func (mr *MockClientMockRecorder) DeleteEmail(emailID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEmail", reflect.TypeOf((*MockClient)(nil).DeleteEmail), emailID)
+}
+
+// DeleteUNSName mocks base method.
+func (m *MockClient) DeleteUNSName(name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUNSName", name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteUNSName indicates an expected call of DeleteUNSName.
+func (mr *MockClientMockRecorder) DeleteUNSName(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUNSName", reflect.TypeOf((*MockClient)(nil).DeleteUNSName), name)
+}
+
+// DeleteVoucher mocks base method.
+func (m *MockClient) DeleteVoucher(voucherID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVoucher", voucherID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteVoucher indicates an expected call of DeleteVoucher.
+func (mr *MockClientMockRecorder) DeleteVoucher(voucherID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVoucher", reflect.TypeOf((*MockClient)(nil).DeleteVoucher), voucherID)
+}
+
+// EditChannelMessage mocks base method.
+func (m *MockClient) EditChannelMessage(channelID string, messageID uint64, message string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EditChannelMessage", channelID, messageID, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EditChannelMessage indicates an expected call of EditChannelMessage.
+func (mr *MockClientMockRecorder) EditChannelMessage(channelID, messageID, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EditChannelMessage", reflect.TypeOf((*MockClient)(nil).EditChannelMessage), channelID, messageID, message)
+}
+
 // EnableChannelReadOnly mocks base method.
 func (m *MockClient) EnableChannelReadOnly(channelID string, readOnly bool) error {
 	m.ctrl.T.Helper()
@@ -138,6 +416,20 @@ func (mr *MockClientMockRecorder) EnableChannelReadOnly(channelID, readOnly inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableChannelReadOnly", reflect.TypeOf((*MockClient)(nil).EnableChannelReadOnly), channelID, readOnly)
 }
 
+// EnableMining mocks base method.
+func (m *MockClient) EnableMining(enabled bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableMining", enabled)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// EnableMining indicates an expected call of EnableMining.
+func (mr *MockClientMockRecorder) EnableMining(enabled interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableMining", reflect.TypeOf((*MockClient)(nil).EnableMining), enabled)
+}
+
 // EnableReadOnly mocks base method.
 func (m *MockClient) EnableReadOnly(channelID string, readOnly bool) error {
 	m.ctrl.T.Helper()
@@ -152,6 +444,20 @@ func (mr *MockClientMockRecorder) EnableReadOnly(channelID, readOnly interface{}
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableReadOnly", reflect.TypeOf((*MockClient)(nil).EnableReadOnly), channelID, readOnly)
 }
 
+// ForwardEmail mocks base method.
+func (m *MockClient) ForwardEmail(emailID uint64, task structs.SendEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ForwardEmail", emailID, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ForwardEmail indicates an expected call of ForwardEmail.
+func (mr *MockClientMockRecorder) ForwardEmail(emailID, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForwardEmail", reflect.TypeOf((*MockClient)(nil).ForwardEmail), emailID, task)
+}
+
 // GetBalance mocks base method.
 func (m *MockClient) GetBalance() (float64, error) {
 	m.ctrl.T.Helper()
@@ -167,6 +473,51 @@ func (mr *MockClientMockRecorder) GetBalance() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBalance", reflect.TypeOf((*MockClient)(nil).GetBalance))
 }
 
+// GetCards mocks base method.
+func (m *MockClient) GetCards() ([]structs.Card, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCards")
+	ret0, _ := ret[0].([]structs.Card)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCards indicates an expected call of GetCards.
+func (mr *MockClientMockRecorder) GetCards() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCards", reflect.TypeOf((*MockClient)(nil).GetCards))
+}
+
+// GetChannelAvatar mocks base method.
+func (m *MockClient) GetChannelAvatar(channelID, avatarID string) (structs.Avatar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelAvatar", channelID, avatarID)
+	ret0, _ := ret[0].(structs.Avatar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelAvatar indicates an expected call of GetChannelAvatar.
+func (mr *MockClientMockRecorder) GetChannelAvatar(channelID, avatarID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelAvatar", reflect.TypeOf((*MockClient)(nil).GetChannelAvatar), channelID, avatarID)
+}
+
+// GetChannelBannedContacts mocks base method.
+func (m *MockClient) GetChannelBannedContacts(channelID string) ([]structs.ChannelBan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelBannedContacts", channelID)
+	ret0, _ := ret[0].([]structs.ChannelBan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetChannelBannedContacts indicates an expected call of GetChannelBannedContacts.
+func (mr *MockClientMockRecorder) GetChannelBannedContacts(channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelBannedContacts", reflect.TypeOf((*MockClient)(nil).GetChannelBannedContacts), channelID)
+}
+
 // GetChannelContacts mocks base method.
 func (m *MockClient) GetChannelContacts(channelID string) ([]structs.ChannelContactData, error) {
 	m.ctrl.T.Helper()
@@ -182,6 +533,20 @@ func (mr *MockClientMockRecorder) GetChannelContacts(channelID interface{}) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelContacts", reflect.TypeOf((*MockClient)(nil).GetChannelContacts), channelID)
 }
 
+// GetChannelHistoryIterator mocks base method.
+func (m *MockClient) GetChannelHistoryIterator(task structs.ChannelHistoryIteratorTask) *v2.ChannelHistoryIterator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetChannelHistoryIterator", task)
+	ret0, _ := ret[0].(*v2.ChannelHistoryIterator)
+	return ret0
+}
+
+// GetChannelHistoryIterator indicates an expected call of GetChannelHistoryIterator.
+func (mr *MockClientMockRecorder) GetChannelHistoryIterator(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetChannelHistoryIterator", reflect.TypeOf((*MockClient)(nil).GetChannelHistoryIterator), task)
+}
+
 // GetChannelInfo mocks base method.
 func (m *MockClient) GetChannelInfo(channelID string) (structs.ChannelData, error) {
 	m.ctrl.T.Helper()
@@ -272,6 +637,65 @@ func (mr *MockClientMockRecorder) GetContact(pubkeyOrNick interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContact", reflect.TypeOf((*MockClient)(nil).GetContact), pubkeyOrNick)
 }
 
+// GetContactAvatar mocks base method.
+func (m *MockClient) GetContactAvatar(pubkey, avatarHash string) (structs.Avatar, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactAvatar", pubkey, avatarHash)
+	ret0, _ := ret[0].(structs.Avatar)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactAvatar indicates an expected call of GetContactAvatar.
+func (mr *MockClientMockRecorder) GetContactAvatar(pubkey, avatarHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactAvatar", reflect.TypeOf((*MockClient)(nil).GetContactAvatar), pubkey, avatarHash)
+}
+
+// GetContactGroups mocks base method.
+func (m *MockClient) GetContactGroups() ([]string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactGroups")
+	ret0, _ := ret[0].([]string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactGroups indicates an expected call of GetContactGroups.
+func (mr *MockClientMockRecorder) GetContactGroups() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactGroups", reflect.TypeOf((*MockClient)(nil).GetContactGroups))
+}
+
+// GetContactMessages mocks base method.
+func (m *MockClient) GetContactMessages(pubkey string, offset, limit int) ([]structs.InstantMessage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactMessages", pubkey, offset, limit)
+	ret0, _ := ret[0].([]structs.InstantMessage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactMessages indicates an expected call of GetContactMessages.
+func (mr *MockClientMockRecorder) GetContactMessages(pubkey, offset, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactMessages", reflect.TypeOf((*MockClient)(nil).GetContactMessages), pubkey, offset, limit)
+}
+
+// GetContactMessagesIterator mocks base method.
+func (m *MockClient) GetContactMessagesIterator(pubkey string, pageSize int) *v2.ContactMessagesIterator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactMessagesIterator", pubkey, pageSize)
+	ret0, _ := ret[0].(*v2.ContactMessagesIterator)
+	return ret0
+}
+
+// GetContactMessagesIterator indicates an expected call of GetContactMessagesIterator.
+func (mr *MockClientMockRecorder) GetContactMessagesIterator(pubkey, pageSize interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactMessagesIterator", reflect.TypeOf((*MockClient)(nil).GetContactMessagesIterator), pubkey, pageSize)
+}
+
 // GetContacts mocks base method.
 func (m *MockClient) GetContacts(filter string) ([]structs.ContactData, error) {
 	m.ctrl.T.Helper()
@@ -287,6 +711,66 @@ func (mr *MockClientMockRecorder) GetContacts(filter interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContacts", reflect.TypeOf((*MockClient)(nil).GetContacts), filter)
 }
 
+// GetContactsByGroup mocks base method.
+func (m *MockClient) GetContactsByGroup(groupName string) ([]structs.ContactData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetContactsByGroup", groupName)
+	ret0, _ := ret[0].([]structs.ContactData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetContactsByGroup indicates an expected call of GetContactsByGroup.
+func (mr *MockClientMockRecorder) GetContactsByGroup(groupName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetContactsByGroup", reflect.TypeOf((*MockClient)(nil).GetContactsByGroup), groupName)
+}
+
+// GetEmail mocks base method.
+func (m *MockClient) GetEmail(emailID uint64) (structs.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmail", emailID)
+	ret0, _ := ret[0].(structs.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmail indicates an expected call of GetEmail.
+func (mr *MockClientMockRecorder) GetEmail(emailID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmail", reflect.TypeOf((*MockClient)(nil).GetEmail), emailID)
+}
+
+// GetEmailFolder mocks base method.
+func (m *MockClient) GetEmailFolder(folder consts.EmailFolder, filter string) ([]uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmailFolder", folder, filter)
+	ret0, _ := ret[0].([]uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmailFolder indicates an expected call of GetEmailFolder.
+func (mr *MockClientMockRecorder) GetEmailFolder(folder, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmailFolder", reflect.TypeOf((*MockClient)(nil).GetEmailFolder), folder, filter)
+}
+
+// GetEmails mocks base method.
+func (m *MockClient) GetEmails(folder consts.EmailFolder, filter string) ([]structs.Email, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEmails", folder, filter)
+	ret0, _ := ret[0].([]structs.Email)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEmails indicates an expected call of GetEmails.
+func (mr *MockClientMockRecorder) GetEmails(folder, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEmails", reflect.TypeOf((*MockClient)(nil).GetEmails), folder, filter)
+}
+
 // GetFinanceHistory mocks base method.
 func (m *MockClient) GetFinanceHistory(task structs.GetFinanceHistoryTask) ([]structs.FinanceHistoryData, error) {
 	m.ctrl.T.Helper()
@@ -302,34 +786,153 @@ func (mr *MockClientMockRecorder) GetFinanceHistory(task interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinanceHistory", reflect.TypeOf((*MockClient)(nil).GetFinanceHistory), task)
 }
 
-// GetFinanceInfo mocks base method.
-func (m *MockClient) GetFinanceInfo() (structs.FinanceInfo, error) {
+// GetFinanceHistoryIterator mocks base method.
+func (m *MockClient) GetFinanceHistoryIterator(task structs.FinanceHistoryIteratorTask) *v2.FinanceHistoryIterator {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFinanceHistoryIterator", task)
+	ret0, _ := ret[0].(*v2.FinanceHistoryIterator)
+	return ret0
+}
+
+// GetFinanceHistoryIterator indicates an expected call of GetFinanceHistoryIterator.
+func (mr *MockClientMockRecorder) GetFinanceHistoryIterator(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinanceHistoryIterator", reflect.TypeOf((*MockClient)(nil).GetFinanceHistoryIterator), task)
+}
+
+// GetFinanceInfo mocks base method.
+func (m *MockClient) GetFinanceInfo() (structs.FinanceInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFinanceInfo")
+	ret0, _ := ret[0].(structs.FinanceInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFinanceInfo indicates an expected call of GetFinanceInfo.
+func (mr *MockClientMockRecorder) GetFinanceInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinanceInfo", reflect.TypeOf((*MockClient)(nil).GetFinanceInfo))
+}
+
+// GetIncomingUNSTransfers mocks base method.
+func (m *MockClient) GetIncomingUNSTransfers() ([]structs.UNSTransfer, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetIncomingUNSTransfers")
+	ret0, _ := ret[0].([]structs.UNSTransfer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetIncomingUNSTransfers indicates an expected call of GetIncomingUNSTransfers.
+func (mr *MockClientMockRecorder) GetIncomingUNSTransfers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIncomingUNSTransfers", reflect.TypeOf((*MockClient)(nil).GetIncomingUNSTransfers))
+}
+
+// GetInvoiceByReferenceNumber mocks base method.
+func (m *MockClient) GetInvoiceByReferenceNumber(referenceNumber string) (structs.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoiceByReferenceNumber", referenceNumber)
+	ret0, _ := ret[0].(structs.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoiceByReferenceNumber indicates an expected call of GetInvoiceByReferenceNumber.
+func (mr *MockClientMockRecorder) GetInvoiceByReferenceNumber(referenceNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoiceByReferenceNumber", reflect.TypeOf((*MockClient)(nil).GetInvoiceByReferenceNumber), referenceNumber)
+}
+
+// GetInvoices mocks base method.
+func (m *MockClient) GetInvoices(task structs.GetInvoicesTask) ([]structs.Invoice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetInvoices", task)
+	ret0, _ := ret[0].([]structs.Invoice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetInvoices indicates an expected call of GetInvoices.
+func (mr *MockClientMockRecorder) GetInvoices(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInvoices", reflect.TypeOf((*MockClient)(nil).GetInvoices), task)
+}
+
+// GetMiningBlocks mocks base method.
+func (m *MockClient) GetMiningBlocks(task structs.GetMiningBlocksTask) ([]structs.MiningBlock, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMiningBlocks", task)
+	ret0, _ := ret[0].([]structs.MiningBlock)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMiningBlocks indicates an expected call of GetMiningBlocks.
+func (mr *MockClientMockRecorder) GetMiningBlocks(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMiningBlocks", reflect.TypeOf((*MockClient)(nil).GetMiningBlocks), task)
+}
+
+// GetMiningInfo mocks base method.
+func (m *MockClient) GetMiningInfo() (structs.MiningInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMiningInfo")
+	ret0, _ := ret[0].(structs.MiningInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMiningInfo indicates an expected call of GetMiningInfo.
+func (mr *MockClientMockRecorder) GetMiningInfo() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMiningInfo", reflect.TypeOf((*MockClient)(nil).GetMiningInfo))
+}
+
+// GetNetworkConnections mocks base method.
+func (m *MockClient) GetNetworkConnections() ([]structs.PeerInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNetworkConnections")
+	ret0, _ := ret[0].([]structs.PeerInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNetworkConnections indicates an expected call of GetNetworkConnections.
+func (mr *MockClientMockRecorder) GetNetworkConnections() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkConnections", reflect.TypeOf((*MockClient)(nil).GetNetworkConnections))
+}
+
+// GetOutgoingUNSTransfers mocks base method.
+func (m *MockClient) GetOutgoingUNSTransfers() ([]structs.UNSTransfer, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetFinanceInfo")
-	ret0, _ := ret[0].(structs.FinanceInfo)
+	ret := m.ctrl.Call(m, "GetOutgoingUNSTransfers")
+	ret0, _ := ret[0].([]structs.UNSTransfer)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetFinanceInfo indicates an expected call of GetFinanceInfo.
-func (mr *MockClientMockRecorder) GetFinanceInfo() *gomock.Call {
+// GetOutgoingUNSTransfers indicates an expected call of GetOutgoingUNSTransfers.
+func (mr *MockClientMockRecorder) GetOutgoingUNSTransfers() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFinanceInfo", reflect.TypeOf((*MockClient)(nil).GetFinanceInfo))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOutgoingUNSTransfers", reflect.TypeOf((*MockClient)(nil).GetOutgoingUNSTransfers))
 }
 
-// GetNetworkConnections mocks base method.
-func (m *MockClient) GetNetworkConnections() ([]structs.PeerInfo, error) {
+// GetOwnAvatar mocks base method.
+func (m *MockClient) GetOwnAvatar() (structs.Avatar, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetNetworkConnections")
-	ret0, _ := ret[0].([]structs.PeerInfo)
+	ret := m.ctrl.Call(m, "GetOwnAvatar")
+	ret0, _ := ret[0].(structs.Avatar)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetNetworkConnections indicates an expected call of GetNetworkConnections.
-func (mr *MockClientMockRecorder) GetNetworkConnections() *gomock.Call {
+// GetOwnAvatar indicates an expected call of GetOwnAvatar.
+func (mr *MockClientMockRecorder) GetOwnAvatar() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkConnections", reflect.TypeOf((*MockClient)(nil).GetNetworkConnections))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnAvatar", reflect.TypeOf((*MockClient)(nil).GetOwnAvatar))
 }
 
 // GetOwnContact mocks base method.
@@ -347,6 +950,21 @@ func (mr *MockClientMockRecorder) GetOwnContact() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnContact", reflect.TypeOf((*MockClient)(nil).GetOwnContact))
 }
 
+// GetOwnUNSNames mocks base method.
+func (m *MockClient) GetOwnUNSNames() ([]structs.UNSRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOwnUNSNames")
+	ret0, _ := ret[0].([]structs.UNSRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOwnUNSNames indicates an expected call of GetOwnUNSNames.
+func (mr *MockClientMockRecorder) GetOwnUNSNames() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOwnUNSNames", reflect.TypeOf((*MockClient)(nil).GetOwnUNSNames))
+}
+
 // GetProfileStatus mocks base method.
 func (m *MockClient) GetProfileStatus() (structs.ProfileStatus, error) {
 	m.ctrl.T.Helper()
@@ -422,6 +1040,36 @@ func (mr *MockClientMockRecorder) GetUUSDBalance() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUUSDBalance", reflect.TypeOf((*MockClient)(nil).GetUUSDBalance))
 }
 
+// GetVoucherBatch mocks base method.
+func (m *MockClient) GetVoucherBatch(referenceNumber string) ([]structs.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVoucherBatch", referenceNumber)
+	ret0, _ := ret[0].([]structs.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVoucherBatch indicates an expected call of GetVoucherBatch.
+func (mr *MockClientMockRecorder) GetVoucherBatch(referenceNumber interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVoucherBatch", reflect.TypeOf((*MockClient)(nil).GetVoucherBatch), referenceNumber)
+}
+
+// GetVouchers mocks base method.
+func (m *MockClient) GetVouchers(task structs.GetVouchersTask) ([]structs.Voucher, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVouchers", task)
+	ret0, _ := ret[0].([]structs.Voucher)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVouchers indicates an expected call of GetVouchers.
+func (mr *MockClientMockRecorder) GetVouchers(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVouchers", reflect.TypeOf((*MockClient)(nil).GetVouchers), task)
+}
+
 // GetWebSocketState mocks base method.
 func (m *MockClient) GetWebSocketState() (int64, error) {
 	m.ctrl.T.Helper()
@@ -457,6 +1105,91 @@ func (mr *MockClientMockRecorder) JoinChannel(channelID interface{}, password ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "JoinChannel", reflect.TypeOf((*MockClient)(nil).JoinChannel), varargs...)
 }
 
+// LeaveChannel mocks base method.
+func (m *MockClient) LeaveChannel(channelID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LeaveChannel", channelID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// LeaveChannel indicates an expected call of LeaveChannel.
+func (mr *MockClientMockRecorder) LeaveChannel(channelID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LeaveChannel", reflect.TypeOf((*MockClient)(nil).LeaveChannel), channelID)
+}
+
+// ModifyChannel mocks base method.
+func (m *MockClient) ModifyChannel(channelID string, task structs.ModifyChannelTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyChannel", channelID, task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyChannel indicates an expected call of ModifyChannel.
+func (mr *MockClientMockRecorder) ModifyChannel(channelID, task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyChannel", reflect.TypeOf((*MockClient)(nil).ModifyChannel), channelID, task)
+}
+
+// ModifyUNSName mocks base method.
+func (m *MockClient) ModifyUNSName(task structs.UNSRecordTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyUNSName", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyUNSName indicates an expected call of ModifyUNSName.
+func (mr *MockClientMockRecorder) ModifyUNSName(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyUNSName", reflect.TypeOf((*MockClient)(nil).ModifyUNSName), task)
+}
+
+// PinChannelMessage mocks base method.
+func (m *MockClient) PinChannelMessage(channelID string, messageID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PinChannelMessage", channelID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PinChannelMessage indicates an expected call of PinChannelMessage.
+func (mr *MockClientMockRecorder) PinChannelMessage(channelID, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PinChannelMessage", reflect.TypeOf((*MockClient)(nil).PinChannelMessage), channelID, messageID)
+}
+
+// QuoteChannelMessage mocks base method.
+func (m *MockClient) QuoteChannelMessage(channelID string, messageID uint64, message string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuoteChannelMessage", channelID, messageID, message)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuoteChannelMessage indicates an expected call of QuoteChannelMessage.
+func (mr *MockClientMockRecorder) QuoteChannelMessage(channelID, messageID, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuoteChannelMessage", reflect.TypeOf((*MockClient)(nil).QuoteChannelMessage), channelID, messageID, message)
+}
+
+// RegisterUNSName mocks base method.
+func (m *MockClient) RegisterUNSName(task structs.UNSRecordTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterUNSName", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RegisterUNSName indicates an expected call of RegisterUNSName.
+func (mr *MockClientMockRecorder) RegisterUNSName(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUNSName", reflect.TypeOf((*MockClient)(nil).RegisterUNSName), task)
+}
+
 // RejectAuthRequest mocks base method.
 func (m *MockClient) RejectAuthRequest(pubkey, message string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -486,6 +1219,135 @@ func (mr *MockClientMockRecorder) RemoveChannelMessage(channelID, messageID inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChannelMessage", reflect.TypeOf((*MockClient)(nil).RemoveChannelMessage), channelID, messageID)
 }
 
+// RemoveChannelModerator mocks base method.
+func (m *MockClient) RemoveChannelModerator(channelID, moderatorPubkey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveChannelModerator", channelID, moderatorPubkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveChannelModerator indicates an expected call of RemoveChannelModerator.
+func (mr *MockClientMockRecorder) RemoveChannelModerator(channelID, moderatorPubkey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveChannelModerator", reflect.TypeOf((*MockClient)(nil).RemoveChannelModerator), channelID, moderatorPubkey)
+}
+
+// RemoveContact mocks base method.
+func (m *MockClient) RemoveContact(pubkey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveContact", pubkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveContact indicates an expected call of RemoveContact.
+func (mr *MockClientMockRecorder) RemoveContact(pubkey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveContact", reflect.TypeOf((*MockClient)(nil).RemoveContact), pubkey)
+}
+
+// RenameCard mocks base method.
+func (m *MockClient) RenameCard(cardID, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameCard", cardID, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameCard indicates an expected call of RenameCard.
+func (mr *MockClientMockRecorder) RenameCard(cardID, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameCard", reflect.TypeOf((*MockClient)(nil).RenameCard), cardID, name)
+}
+
+// RenameContactGroup mocks base method.
+func (m *MockClient) RenameContactGroup(oldName, newName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RenameContactGroup", oldName, newName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RenameContactGroup indicates an expected call of RenameContactGroup.
+func (mr *MockClientMockRecorder) RenameContactGroup(oldName, newName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RenameContactGroup", reflect.TypeOf((*MockClient)(nil).RenameContactGroup), oldName, newName)
+}
+
+// ReplyChannelMessage mocks base method.
+func (m *MockClient) ReplyChannelMessage(channelID string, messageID uint64, message string) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplyChannelMessage", channelID, messageID, message)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplyChannelMessage indicates an expected call of ReplyChannelMessage.
+func (mr *MockClientMockRecorder) ReplyChannelMessage(channelID, messageID, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyChannelMessage", reflect.TypeOf((*MockClient)(nil).ReplyChannelMessage), channelID, messageID, message)
+}
+
+// ReplyEmail mocks base method.
+func (m *MockClient) ReplyEmail(emailID uint64, subject, body string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplyEmail", emailID, subject, body)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReplyEmail indicates an expected call of ReplyEmail.
+func (mr *MockClientMockRecorder) ReplyEmail(emailID, subject, body interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplyEmail", reflect.TypeOf((*MockClient)(nil).ReplyEmail), emailID, subject, body)
+}
+
+// RequestUNSTransfer mocks base method.
+func (m *MockClient) RequestUNSTransfer(name, contactPubkeyHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestUNSTransfer", name, contactPubkeyHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RequestUNSTransfer indicates an expected call of RequestUNSTransfer.
+func (mr *MockClientMockRecorder) RequestUNSTransfer(name, contactPubkeyHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestUNSTransfer", reflect.TypeOf((*MockClient)(nil).RequestUNSTransfer), name, contactPubkeyHash)
+}
+
+// SearchUNSByName mocks base method.
+func (m *MockClient) SearchUNSByName(name string) ([]structs.UNSRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUNSByName", name)
+	ret0, _ := ret[0].([]structs.UNSRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUNSByName indicates an expected call of SearchUNSByName.
+func (mr *MockClientMockRecorder) SearchUNSByName(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUNSByName", reflect.TypeOf((*MockClient)(nil).SearchUNSByName), name)
+}
+
+// SearchUNSByPubkey mocks base method.
+func (m *MockClient) SearchUNSByPubkey(pubkey string) ([]structs.UNSRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchUNSByPubkey", pubkey)
+	ret0, _ := ret[0].([]structs.UNSRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchUNSByPubkey indicates an expected call of SearchUNSByPubkey.
+func (mr *MockClientMockRecorder) SearchUNSByPubkey(pubkey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchUNSByPubkey", reflect.TypeOf((*MockClient)(nil).SearchUNSByPubkey), pubkey)
+}
+
 // SendAuthRequest mocks base method.
 func (m *MockClient) SendAuthRequest(pubkey, message string) (bool, error) {
 	m.ctrl.T.Helper()
@@ -546,11 +1408,25 @@ func (mr *MockClientMockRecorder) SendChannelPicture(channelID, base64Image, com
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendChannelPicture", reflect.TypeOf((*MockClient)(nil).SendChannelPicture), channelID, base64Image, comment, filenameForImage)
 }
 
+// SendEmail mocks base method.
+func (m *MockClient) SendEmail(task structs.SendEmailTask) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendEmail", task)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendEmail indicates an expected call of SendEmail.
+func (mr *MockClientMockRecorder) SendEmail(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendEmail", reflect.TypeOf((*MockClient)(nil).SendEmail), task)
+}
+
 // SendInstantMessage mocks base method.
-func (m *MockClient) SendInstantMessage(to, message string) (uint64, error) {
+func (m *MockClient) SendInstantMessage(to, message string) (string, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "SendInstantMessage", to, message)
-	ret0, _ := ret[0].(uint64)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -561,6 +1437,21 @@ func (mr *MockClientMockRecorder) SendInstantMessage(to, message interface{}) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInstantMessage", reflect.TypeOf((*MockClient)(nil).SendInstantMessage), to, message)
 }
 
+// SendInvoice mocks base method.
+func (m *MockClient) SendInvoice(task structs.SendInvoiceTask) (string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendInvoice", task)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SendInvoice indicates an expected call of SendInvoice.
+func (mr *MockClientMockRecorder) SendInvoice(task interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendInvoice", reflect.TypeOf((*MockClient)(nil).SendInvoice), task)
+}
+
 // SendPayment mocks base method.
 func (m *MockClient) SendPayment(task structs.SendPaymentTask) (string, error) {
 	m.ctrl.T.Helper()
@@ -576,6 +1467,76 @@ func (mr *MockClientMockRecorder) SendPayment(task interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendPayment", reflect.TypeOf((*MockClient)(nil).SendPayment), task)
 }
 
+// SetCardColor mocks base method.
+func (m *MockClient) SetCardColor(cardID, color string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetCardColor", cardID, color)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetCardColor indicates an expected call of SetCardColor.
+func (mr *MockClientMockRecorder) SetCardColor(cardID, color interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetCardColor", reflect.TypeOf((*MockClient)(nil).SetCardColor), cardID, color)
+}
+
+// SetChannelModeratorRights mocks base method.
+func (m *MockClient) SetChannelModeratorRights(channelID, moderatorPubkey string, rights structs.ModeratorRights) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetChannelModeratorRights", channelID, moderatorPubkey, rights)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetChannelModeratorRights indicates an expected call of SetChannelModeratorRights.
+func (mr *MockClientMockRecorder) SetChannelModeratorRights(channelID, moderatorPubkey, rights interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetChannelModeratorRights", reflect.TypeOf((*MockClient)(nil).SetChannelModeratorRights), channelID, moderatorPubkey, rights)
+}
+
+// SetContactGroup mocks base method.
+func (m *MockClient) SetContactGroup(pubkey, groupName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContactGroup", pubkey, groupName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetContactGroup indicates an expected call of SetContactGroup.
+func (mr *MockClientMockRecorder) SetContactGroup(pubkey, groupName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContactGroup", reflect.TypeOf((*MockClient)(nil).SetContactGroup), pubkey, groupName)
+}
+
+// SetContactNick mocks base method.
+func (m *MockClient) SetContactNick(pubkey, nick string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetContactNick", pubkey, nick)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetContactNick indicates an expected call of SetContactNick.
+func (mr *MockClientMockRecorder) SetContactNick(pubkey, nick interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetContactNick", reflect.TypeOf((*MockClient)(nil).SetContactNick), pubkey, nick)
+}
+
+// SetProfileAvatar mocks base method.
+func (m *MockClient) SetProfileAvatar(image io.Reader) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetProfileAvatar", image)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetProfileAvatar indicates an expected call of SetProfileAvatar.
+func (mr *MockClientMockRecorder) SetProfileAvatar(image interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetProfileAvatar", reflect.TypeOf((*MockClient)(nil).SetProfileAvatar), image)
+}
+
 // SetProfileData mocks base method.
 func (m *MockClient) SetProfileData(nick, firstName, lastName string) error {
 	m.ctrl.T.Helper()
@@ -618,6 +1579,21 @@ func (mr *MockClientMockRecorder) SetWebSocketState(task interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetWebSocketState", reflect.TypeOf((*MockClient)(nil).SetWebSocketState), task)
 }
 
+// Subscribe mocks base method.
+func (m *MockClient) Subscribe(ctx context.Context, filter websocket.EventFilter) (<-chan websocket.WsEvent, <-chan error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Subscribe", ctx, filter)
+	ret0, _ := ret[0].(<-chan websocket.WsEvent)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// Subscribe indicates an expected call of Subscribe.
+func (mr *MockClientMockRecorder) Subscribe(ctx, filter interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Subscribe", reflect.TypeOf((*MockClient)(nil).Subscribe), ctx, filter)
+}
+
 // ToogleChannelNotifications mocks base method.
 func (m *MockClient) ToogleChannelNotifications(channelID string, enabled bool) error {
 	m.ctrl.T.Helper()
@@ -647,6 +1623,48 @@ func (mr *MockClientMockRecorder) UCodeEncode(dataHexCode, coder, format, imageS
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UCodeEncode", reflect.TypeOf((*MockClient)(nil).UCodeEncode), dataHexCode, coder, format, imageSize)
 }
 
+// UnbanChannelContact mocks base method.
+func (m *MockClient) UnbanChannelContact(channelID, pubkeyHash string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnbanChannelContact", channelID, pubkeyHash)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnbanChannelContact indicates an expected call of UnbanChannelContact.
+func (mr *MockClientMockRecorder) UnbanChannelContact(channelID, pubkeyHash interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnbanChannelContact", reflect.TypeOf((*MockClient)(nil).UnbanChannelContact), channelID, pubkeyHash)
+}
+
+// UnblockContact mocks base method.
+func (m *MockClient) UnblockContact(pubkey string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnblockContact", pubkey)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnblockContact indicates an expected call of UnblockContact.
+func (mr *MockClientMockRecorder) UnblockContact(pubkey interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnblockContact", reflect.TypeOf((*MockClient)(nil).UnblockContact), pubkey)
+}
+
+// UnpinChannelMessage mocks base method.
+func (m *MockClient) UnpinChannelMessage(channelID string, messageID uint64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnpinChannelMessage", channelID, messageID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnpinChannelMessage indicates an expected call of UnpinChannelMessage.
+func (mr *MockClientMockRecorder) UnpinChannelMessage(channelID, messageID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnpinChannelMessage", reflect.TypeOf((*MockClient)(nil).UnpinChannelMessage), channelID, messageID)
+}
+
 // UseVoucher mocks base method.
 func (m *MockClient) UseVoucher(voucherID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -662,6 +1680,20 @@ func (mr *MockClientMockRecorder) UseVoucher(voucherID interface{}) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseVoucher", reflect.TypeOf((*MockClient)(nil).UseVoucher), voucherID)
 }
 
+// WithContext mocks base method.
+func (m *MockClient) WithContext(ctx context.Context) v2.Client {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithContext", ctx)
+	ret0, _ := ret[0].(v2.Client)
+	return ret0
+}
+
+// WithContext indicates an expected call of WithContext.
+func (mr *MockClientMockRecorder) WithContext(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithContext", reflect.TypeOf((*MockClient)(nil).WithContext), ctx)
+}
+
 // WsSubscribe mocks base method.
 func (m *MockClient) WsSubscribe(task websocket.WsSubscribeTask) (websocket.Handler, error) {
 	m.ctrl.T.Helper()
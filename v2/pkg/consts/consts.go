@@ -1,5 +1,7 @@
 package consts
 
+import "time"
+
 const (
 	ChannelTypeRegistered ChannelType = iota
 	ChannelTypeRecent
@@ -31,3 +33,76 @@ const (
 	StatusCodeDoNotDisturb = 4099
 	StatusCodeInvisible    = 32768
 )
+
+type InvoiceStatus string
+
+const (
+	InvoiceStatusAwaiting InvoiceStatus = "AWAITING_APPROVAL"
+	InvoiceStatusAccepted InvoiceStatus = "APPROVED"
+	InvoiceStatusDeclined InvoiceStatus = "DECLINED"
+	InvoiceStatusCanceled InvoiceStatus = "CANCELED"
+	InvoiceStatusExpired  InvoiceStatus = "EXPIRED"
+)
+
+// FinanceHistoryFilter - type of the finance history transactions
+type FinanceHistoryFilter string
+
+const (
+	FinanceHistoryAll               FinanceHistoryFilter = "ALL_TRANSACTIONS"
+	FinanceHistoryTransfers         FinanceHistoryFilter = "ALL_TRANSFERS"
+	FinanceHistoryIncomingTransfers FinanceHistoryFilter = "INCOMING_TRANSFERS"
+	FinanceHistoryOutgoingTransfers FinanceHistoryFilter = "OUTGOING_TRANSFERS"
+	FinanceHistoryCards             FinanceHistoryFilter = "ALL_CARDS"
+	FinanceHistoryCreatedCards      FinanceHistoryFilter = "CREATED_CARDS"
+	FinanceHistoryDeletedCards      FinanceHistoryFilter = "DELETED_CARDS"
+	FinanceHistoryVouchers          FinanceHistoryFilter = "ALL_VOUCHERS"
+	FinanceHistoryCreatedVouchers   FinanceHistoryFilter = "CREATED_VOUCHERS"
+	FinanceHistoryActivatedVouchers FinanceHistoryFilter = "ACTIVATED_VOUCHERS"
+	FinanceHistoryDeletedVouchers   FinanceHistoryFilter = "DELETED_VOUCHERS"
+	FinanceHistoryInvoices          FinanceHistoryFilter = "ALL_INVOICES"
+	FinanceHistoryMining            FinanceHistoryFilter = "ALL_MINING"
+	FinanceHistoryUNS               FinanceHistoryFilter = "ALL_UNS"
+	FinanceHistoryInterest          FinanceHistoryFilter = "ALL_INTEREST"
+)
+
+type EmailFolder int
+
+const (
+	EmailFolderInbox  EmailFolder = 1
+	EmailFolderDrafts EmailFolder = 2
+	EmailFolderSent   EmailFolder = 4
+	EmailFolderOutbox EmailFolder = 8
+	EmailFolderTrash  EmailFolder = 16
+)
+
+// EmailFolders - all uMail folders
+var EmailFolders = []EmailFolder{
+	EmailFolderInbox,
+	EmailFolderDrafts,
+	EmailFolderSent,
+	EmailFolderOutbox,
+	EmailFolderTrash,
+}
+
+type ImageFormat string
+
+const (
+	ImageFormatUnknown ImageFormat = ""
+	ImageFormatPNG     ImageFormat = "PNG"
+	ImageFormatJPG     ImageFormat = "JPG"
+)
+
+// HistoryDirection - channel history iteration order
+type HistoryDirection int
+
+const (
+	HistoryBackward HistoryDirection = iota // from newest to oldest messages
+	HistoryForward                          // from oldest to newest messages
+)
+
+// DateTimeLayouts - API dateTime fields formats
+var DateTimeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05",
+}
@@ -0,0 +1,137 @@
+package errors
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Category - the stage of the API request at which the error occurred
+type Category int
+
+const (
+	CategoryAPI       Category = iota + 1 // the client returned an error
+	CategoryTransport                     // request was not delivered or response was not received
+	CategoryDecode                        // request can't be encoded or response can't be decoded
+)
+
+func (c Category) String() string {
+	switch c {
+	case CategoryAPI:
+		return "api"
+	case CategoryTransport:
+		return "transport"
+	case CategoryDecode:
+		return "decode"
+	default:
+		return "unknown"
+	}
+}
+
+// Code - parsed API error code
+type Code int
+
+const (
+	CodeUnknown Code = iota
+	CodeContactNotFound
+	CodeChannelNotFound
+	CodeInsufficientFunds
+	CodeAccessDenied
+	CodeMethodNotFound
+	CodeInvalidParams
+)
+
+// codeMessages - substrings of the client error text by which the code is determined
+var codeMessages = []struct {
+	code  Code
+	texts []string
+}{
+	{CodeContactNotFound, []string{"contact not found", "contact is not found"}},
+	{CodeChannelNotFound, []string{"channel not found", "channel is not found"}},
+	{CodeInsufficientFunds, []string{"insufficient funds", "not enough funds", "not enough money"}},
+	{CodeAccessDenied, []string{"access denied", "invalid token", "wrong token"}},
+	{CodeMethodNotFound, []string{"method not found", "unknown method"}},
+	{CodeInvalidParams, []string{"invalid param", "wrong param", "missing param"}},
+}
+
+// ParseCode - determine API error code by the client error text
+func ParseCode(message string) Code {
+	message = strings.ToLower(message)
+	for _, c := range codeMessages {
+		for _, text := range c.texts {
+			if strings.Contains(message, text) {
+				return c.code
+			}
+		}
+	}
+	return CodeUnknown
+}
+
+// APIError - API request error.
+// use errors.Is with the Err* values to check the category or code:
+//
+//	if errors.Is(err, uerrors.ErrInsufficientFunds) { ... }
+type APIError struct {
+	Method   string   // API method name
+	Category Category // request stage
+	Code     Code     // parsed from Message, only for CategoryAPI
+	Message  string   // raw error text returned by the client
+	Err      error    // underlying error for transport & decode errors
+}
+
+var (
+	ErrAPI       = &APIError{Category: CategoryAPI}
+	ErrTransport = &APIError{Category: CategoryTransport}
+	ErrDecode    = &APIError{Category: CategoryDecode}
+
+	ErrContactNotFound   = &APIError{Category: CategoryAPI, Code: CodeContactNotFound}
+	ErrChannelNotFound   = &APIError{Category: CategoryAPI, Code: CodeChannelNotFound}
+	ErrInsufficientFunds = &APIError{Category: CategoryAPI, Code: CodeInsufficientFunds}
+	ErrAccessDenied      = &APIError{Category: CategoryAPI, Code: CodeAccessDenied}
+	ErrMethodNotFound    = &APIError{Category: CategoryAPI, Code: CodeMethodNotFound}
+	ErrInvalidParams     = &APIError{Category: CategoryAPI, Code: CodeInvalidParams}
+)
+
+// NewAPIError - create an error from the client error text
+func NewAPIError(method, message string) *APIError {
+	return &APIError{
+		Method:   method,
+		Category: CategoryAPI,
+		Code:     ParseCode(message),
+		Message:  message,
+	}
+}
+
+// NewTransportError - create an error for a failed request delivery
+func NewTransportError(method string, err error) *APIError {
+	return &APIError{Method: method, Category: CategoryTransport, Err: err}
+}
+
+// NewDecodeError - create an error for a request or response that can't be processed
+func NewDecodeError(method string, err error) *APIError {
+	return &APIError{Method: method, Category: CategoryDecode, Err: err}
+}
+
+func (e *APIError) Error() string {
+	info := e.Message
+	if e.Err != nil {
+		info = e.Err.Error()
+	}
+	return fmt.Sprintf("%s: %s error: %s", e.Method, e.Category, info)
+}
+
+func (e *APIError) Unwrap() error {
+	return e.Err
+}
+
+// Is reports whether the error matches the target.
+// empty fields of the target are not compared
+func (e *APIError) Is(target error) bool {
+	t, ok := target.(*APIError)
+	if !ok {
+		return false
+	}
+
+	return (t.Method == "" || t.Method == e.Method) &&
+		(t.Category == 0 || t.Category == e.Category) &&
+		(t.Code == CodeUnknown || t.Code == e.Code)
+}
@@ -1,26 +1,50 @@
 package errors
 
-import "strings"
-
-var connBrokenErrorInfo = []string{
-	"read: connection reset by peer",
-	"context deadline exceeded",
-	"client disconected",
-	"connection refused",
-	"write: broken pipe",
-	"EOF",
-}
+import (
+	goerrors "errors"
+	"fmt"
+	"io"
+	"net"
+	"syscall"
+)
 
-// CheckErrorConnBroken - check the text of the request error, determining whether the client must be restarted
+// CheckErrorConnBroken - check the request error, determining whether the client must be restarted
 func CheckErrorConnBroken(err error) bool {
 	if err == nil {
 		return false
 	}
 
-	for _, info := range connBrokenErrorInfo {
-		if strings.Contains(err.Error(), info) {
-			return true
-		}
+	var canceledErr *CanceledError
+	if goerrors.As(err, &canceledErr) {
+		// interrupted by the caller, not by the client
+		return false
+	}
+
+	if goerrors.Is(err, ErrTransport) {
+		return true
 	}
-	return false
+
+	var netErr net.Error
+	return goerrors.As(err, &netErr) ||
+		goerrors.Is(err, io.EOF) ||
+		goerrors.Is(err, io.ErrUnexpectedEOF) ||
+		goerrors.Is(err, syscall.ECONNRESET) ||
+		goerrors.Is(err, syscall.ECONNREFUSED) ||
+		goerrors.Is(err, syscall.EPIPE)
+}
+
+// CanceledError - API request was interrupted by the caller's context.
+// use errors.Is(err, context.Canceled) or errors.Is(err, context.DeadlineExceeded)
+// to find out the reason
+type CanceledError struct {
+	Method string // API method name
+	Err    error  // context error
+}
+
+func (e *CanceledError) Error() string {
+	return fmt.Sprintf("request %q canceled: %v", e.Method, e.Err)
+}
+
+func (e *CanceledError) Unwrap() error {
+	return e.Err
 }
@@ -0,0 +1,53 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+	"github.com/Sagleft/utopialib-go/v2/pkg/websocket"
+)
+
+// newTestEvent decodes the event like the ws handler does: numbers as json.Number
+func newTestEvent(t *testing.T, jsonRaw string) websocket.WsEvent {
+	event := websocket.WsEvent{}
+	decoder := json.NewDecoder(bytes.NewReader([]byte(jsonRaw)))
+	decoder.UseNumber()
+	require.NoError(t, decoder.Decode(&event))
+	return event
+}
+
+func TestGetEmailFromEvent(t *testing.T) {
+	event := newTestEvent(t, `{
+		"type": "newEmail",
+		"data": {
+			"id": 9007199254740993,
+			"folder": 1,
+			"subject": "test",
+			"body": "hello",
+			"sender": "SENDER",
+			"receivers": ["RECEIVER"],
+			"dateTime": "2022-09-09T05:47:52.972Z",
+			"isRead": false
+		}
+	}`)
+
+	email, err := GetEmailFromEvent(event)
+	require.NoError(t, err)
+	// large ID is not rounded
+	assert.Equal(t, uint64(9007199254740993), email.ID)
+	assert.Equal(t, consts.EmailFolderInbox, email.Folder)
+	assert.Equal(t, "test", email.Subject)
+	assert.Equal(t, "SENDER", email.Sender)
+	assert.Equal(t, []string{"RECEIVER"}, email.Receivers)
+	assert.Equal(t, "2022-09-09T05:47:52.972Z", email.DateTime)
+
+	// when event data doesn't match
+	event = newTestEvent(t, `{"type": "newEmail", "data": {"id": "not a number"}}`)
+	_, err = GetEmailFromEvent(event)
+	require.Error(t, err)
+}
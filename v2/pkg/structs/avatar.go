@@ -0,0 +1,30 @@
+package structs
+
+import (
+	"bytes"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+)
+
+var (
+	pngSignature = []byte("\x89PNG\r\n\x1a\n")
+	jpgSignature = []byte{0xFF, 0xD8, 0xFF}
+)
+
+// Avatar - decoded avatar image
+type Avatar struct {
+	Data   []byte
+	Format consts.ImageFormat
+}
+
+// DetectImageFormat - get image format by its signature
+func DetectImageFormat(data []byte) consts.ImageFormat {
+	switch {
+	case bytes.HasPrefix(data, pngSignature):
+		return consts.ImageFormatPNG
+	case bytes.HasPrefix(data, jpgSignature):
+		return consts.ImageFormatJPG
+	default:
+		return consts.ImageFormatUnknown
+	}
+}
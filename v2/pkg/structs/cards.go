@@ -0,0 +1,20 @@
+package structs
+
+// Card - crypto card data
+type Card struct {
+	ID        string  `json:"cardid"`
+	Name      string  `json:"name"`
+	Color     string  `json:"color"`   // example: #FBEDC0
+	Balance   float64 `json:"balance"` // Crypton
+	CreatedOn string  `json:"created"` // 2022-09-09T05:47:52.972Z
+}
+
+type CreateCardTask struct {
+	// required
+	Name string `json:"name"`
+
+	// optional
+	Color    string  `json:"color"`          // example: #FBEDC0
+	Prefix   string  `json:"preorderNumber"` // custom card number prefix, up to 4 symbols
+	MaxPrice float64 `json:"-"`              // fail when the card create price is higher
+}
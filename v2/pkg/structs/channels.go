@@ -0,0 +1,36 @@
+package structs
+
+type CreateChannelTask struct {
+	// required
+	Title string `json:"channel_name"`
+
+	// optional
+	Description      string `json:"description"`
+	IsPrivate        bool   `json:"-"`
+	Password         string `json:"password"` // required for a private channel
+	ReadOnly         bool   `json:"read_only"`
+	ReadOnlyPrivacy  bool   `json:"read_only_privacy"`
+	HideInCommonList bool   `json:"hide_in_UI"`
+	GeoTag           string `json:"geo_tag"`   // example: 55.7522,37.6155
+	HashTags         string `json:"hash_tags"` // example: #crypto #news
+	Languages        string `json:"languages"` // example: en,ru
+}
+
+// ModifyChannelTask - channel settings to update. only set fields are sent
+type ModifyChannelTask struct {
+	Title            *string `json:"title"`
+	Description      *string `json:"description"`
+	ReadOnly         *bool   `json:"read_only"`
+	ReadOnlyPrivacy  *bool   `json:"read_only_privacy"`
+	HideInCommonList *bool   `json:"hide_in_UI"`
+	GeoTag           *string `json:"geo_tag"`   // example: 55.7522,37.6155
+	HashTags         *string `json:"hash_tags"` // example: #crypto #news
+	Languages        *string `json:"languages"` // example: en,ru
+}
+
+// ChannelBan - banned channel contact
+type ChannelBan struct {
+	PubkeyHash string `json:"hashedPk"`
+	Nick       string `json:"nick"`
+	BannedOn   string `json:"banTime"` // 2022-09-09T05:47:52.972Z
+}
@@ -0,0 +1,24 @@
+package structs
+
+import "github.com/Sagleft/utopialib-go/v2/pkg/consts"
+
+// Email - uMail message
+type Email struct {
+	ID        uint64             `json:"id"`
+	Folder    consts.EmailFolder `json:"folder"`
+	Subject   string             `json:"subject"`
+	Body      string             `json:"body"`
+	Sender    string             `json:"sender"`    // sender pubkey
+	Receivers []string           `json:"receivers"` // receivers pubkeys
+	DateTime  string             `json:"dateTime"`  // 2022-09-09T05:47:52.972Z
+	IsRead    bool               `json:"isRead"`
+}
+
+type SendEmailTask struct {
+	// required
+	To []string `json:"to"` // pubkeys or uNS names
+
+	// optional
+	Subject string `json:"subject"`
+	Body    string `json:"body"`
+}
@@ -0,0 +1,36 @@
+package structs
+
+// WsContactStatus - contact status change event data
+type WsContactStatus struct {
+	Pubkey string `json:"pk"`
+	Nick   string `json:"nick"`
+	Status int    `json:"status"` // consts.StatusCodeOnline, consts.StatusCodeOffline, etc
+}
+
+// WsAuthRequest - incoming authorization request event data
+type WsAuthRequest struct {
+	Pubkey   string `json:"pk"`
+	Nick     string `json:"nick"`
+	Message  string `json:"message"`
+	DateTime string `json:"dateTime"` // 2022-09-09T05:47:52.972Z
+}
+
+// WsTransfer - wallet transfer event data
+type WsTransfer struct {
+	ReferenceNumber string  `json:"referenceNumber"`
+	Amount          float64 `json:"amount"`
+	Currency        string  `json:"currency"` // example: CRP, UUSD
+	Comment         string  `json:"comment"`
+	CardID          string  `json:"cardid"` // can be empty
+	Pubkey          string  `json:"pk"`     // counterparty
+	IsIncoming      bool    `json:"isIncoming"`
+	DateTime        string  `json:"dateTime"`
+}
+
+// WsChannelJoin - channel contact joined event data
+type WsChannelJoin struct {
+	ChannelID   string `json:"channelid"`
+	ChannelName string `json:"channel"`
+	PubkeyHash  string `json:"hashedPk"`
+	Nick        string `json:"nick"`
+}
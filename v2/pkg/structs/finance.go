@@ -1,5 +1,7 @@
 package structs
 
+import "unicode/utf8"
+
 type FinanceInfo struct {
 	CRP  CryptonFinanceInfo `json:"CRP"`
 	UUSD UUSDFinanceInfo    `json:"USD"`
@@ -47,6 +49,40 @@ type CryptonFinanceInfo struct {
 	VouchersUseEnabled            bool    `json:"vouchersUseEnabled"`
 }
 
+// GetUNSNameRegistrationFee - get the registration fee for the uNS name.
+// only 1-4 symbols names have a special fee, 0 is returned for longer names
+func (i CryptonFinanceInfo) GetUNSNameRegistrationFee(name string) float64 {
+	switch utf8.RuneCountInString(name) {
+	case 1:
+		return i.UnsName1SymbolRegistrationFee
+	case 2:
+		return i.UnsName2SymbolRegistrationFee
+	case 3:
+		return i.UnsName3SymbolRegistrationFee
+	case 4:
+		return i.UnsName4SymbolRegistrationFee
+	default:
+		return 0
+	}
+}
+
+// GetCardCreatePrice - get the card create price for the custom card number prefix.
+// empty prefix - default price
+func (i CryptonFinanceInfo) GetCardCreatePrice(prefix string) float64 {
+	switch utf8.RuneCountInString(prefix) {
+	case 0:
+		return i.CardCreatePriceDefault
+	case 1:
+		return i.CardCreatePrice1Symbol
+	case 2:
+		return i.CardCreatePrice2Symbols
+	case 3:
+		return i.CardCreatePrice3Symbols
+	default:
+		return i.CardCreatePrice4Symbols
+	}
+}
+
 type UUSDFinanceInfo struct {
 	TransferExternalFee   float64 `json:"transferExternalFee"`
 	TransferInternalFee   float64 `json:"transferInternalFee"`
@@ -59,4 +95,28 @@ type UUSDFinanceInfo struct {
 	VouchersUseEnabled    bool    `json:"vouchersUseEnabled"`
 }
 
-type FinanceHistoryData struct{}
+// FinanceHistoryData - finance transaction record
+type FinanceHistoryData struct {
+	ID                int64   `json:"id"`
+	Type              string  `json:"type"` // example: TRANSFER
+	Amount            float64 `json:"amount"`
+	Fee               float64 `json:"fee"`
+	Currency          string  `json:"currency"` // CRP or USD
+	IsIncoming        bool    `json:"isIncoming"`
+	SourcePubkey      string  `json:"sourcePk"`
+	DestinationPubkey string  `json:"destinationPk"`
+	ReferenceNumber   string  `json:"referenceNumber"`
+	CardID            string  `json:"cardId"` // can be empty
+	Comment           string  `json:"comment"`
+	BatchID           int64   `json:"batchId"`  // vouchers batch, can be zero
+	CreatedOn         string  `json:"created"`  // 2022-09-09T05:47:52.972Z
+	FinishedOn        string  `json:"finished"` // can be empty while transaction is processing
+}
+
+// CounterpartyPubkey - get the pubkey of the other side of the transaction
+func (d FinanceHistoryData) CounterpartyPubkey() string {
+	if d.IsIncoming {
+		return d.SourcePubkey
+	}
+	return d.DestinationPubkey
+}
@@ -0,0 +1,49 @@
+package structs
+
+import (
+	"time"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
+)
+
+// Invoice - payment request data
+type Invoice struct {
+	ID              string               `json:"id"`
+	ReferenceNumber string               `json:"referenceNumber"`
+	Amount          float64              `json:"amount"`
+	Comment         string               `json:"comment"`
+	CardID          string               `json:"cardid"`     // destination card
+	Pubkey          string               `json:"pk"`         // invoice counterparty
+	IsIncoming      bool                 `json:"isIncoming"` // invoice issued to us
+	Status          consts.InvoiceStatus `json:"status"`
+	CreatedOn       string               `json:"created"` // 2022-09-09T05:47:52.972Z
+	ModifiedOn      string               `json:"modified"`
+	TransactionID   string               `json:"transactionId"` // set when invoice is paid
+}
+
+// IsAwaiting - invoice is not paid, declined or canceled yet
+func (i Invoice) IsAwaiting() bool {
+	return i.Status == consts.InvoiceStatusAwaiting
+}
+
+type SendInvoiceTask struct {
+	// required
+	CardID string  `json:"cardid"` // card to receive payment
+	Amount float64 `json:"amount"`
+
+	// optional
+	Comment string `json:"comment"`
+	TTL     uint   `json:"ttl"` // invoice lifetime. by default: CryptonFinanceInfo.InvoicesDefaultTtl
+}
+
+type GetInvoicesTask struct {
+	// optional
+	CardID          string               `json:"cardid"`
+	InvoiceID       string               `json:"invoiceId"`
+	Pubkey          string               `json:"pk"`
+	TransactionID   string               `json:"transactionId"`
+	Status          consts.InvoiceStatus `json:"status"`
+	FromDate        time.Time            `json:"startDateTime"`
+	ToDate          time.Time            `json:"endDateTime"`
+	ReferenceNumber string               `json:"referenceNumber"`
+}
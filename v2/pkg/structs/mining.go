@@ -0,0 +1,25 @@
+package structs
+
+// MiningInfo - mining state
+type MiningInfo struct {
+	IsEnabled         bool   `json:"enabled"`
+	Status            int    `json:"status"`
+	StatusDescription string `json:"statusDescription"`
+	LastBlockID       int64  `json:"lastBlockId"`
+	LastMiningOn      string `json:"lastMiningDateTime"` // 2022-09-09T05:47:52.972Z
+}
+
+// MiningBlock - mined block data
+type MiningBlock struct {
+	ID       int64   `json:"blockId"`
+	Reward   float64 `json:"reward"` // Crypton
+	MinedOn  string  `json:"dateTime"`
+	Status   int     `json:"status"`
+	Currency string  `json:"currency"`
+}
+
+type GetMiningBlocksTask struct {
+	// optional
+	QueryOffset uint `json:"offset"`
+	QueryLimit  uint `json:"limit"`
+}
@@ -57,7 +57,7 @@ type InstantMessage struct {
 
 // WsChannelMessage - channel message data
 type WsChannelMessage struct {
-	ID          int64  `json:"id"`
+	ID          uint64 `json:"id"`
 	ChannelName string `json:"channel"`
 	ChannelID   string `json:"channelid"`
 	DateTime    string `json:"dateTime"`
@@ -68,11 +68,16 @@ type WsChannelMessage struct {
 	Pubkey      string `json:"pk"`      // can be empty
 	Text        string `json:"text"`    // message text
 	TopicID     string `json:"topicId"` // for reply
+
+	ReplyTo  *ChannelMessageRef `json:"reply"`    // nil when message is not a reply
+	Quote    *ChannelMessageRef `json:"quote"`    // nil when message has no quote
+	IsPinned bool               `json:"pinned"`   // example: false
+	EditedOn string             `json:"modified"` // empty when message was not edited
 }
 
 // ChannelMessage - channel message data
 type ChannelMessage struct {
-	ID          int64  `json:"id"`
+	ID          uint64 `json:"id"`
 	DateTime    string `json:"dateTime"`
 	PubkeyHash  string `json:"hashedPk"`
 	IsIncoming  bool   `json:"isIncoming"`
@@ -81,6 +86,20 @@ type ChannelMessage struct {
 	Pubkey      string `json:"pk"`      // can be empty
 	Text        string `json:"text"`    // message text
 	TopicID     string `json:"topicId"` // for reply
+
+	ReplyTo  *ChannelMessageRef `json:"reply"`    // nil when message is not a reply
+	Quote    *ChannelMessageRef `json:"quote"`    // nil when message has no quote
+	IsPinned bool               `json:"pinned"`   // example: false
+	EditedOn string             `json:"modified"` // empty when message was not edited
+}
+
+// ChannelMessageRef - replied or quoted channel message
+type ChannelMessageRef struct {
+	ID         uint64 `json:"id"`
+	DateTime   string `json:"dateTime"`
+	PubkeyHash string `json:"hashedPk"`
+	Nick       string `json:"nick"`
+	Text       string `json:"text"`
 }
 
 type ChannelData struct {
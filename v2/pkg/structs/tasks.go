@@ -8,18 +8,28 @@ import (
 
 type GetFinanceHistoryTask struct {
 	// optional
-	Currency          string    `json:"currency"`
-	Filters           string    `json:"filters"`
-	ReferenceNumber   string    `json:"referenceNumber"`
-	FromDate          time.Time `json:"fromDate"`
-	ToDate            time.Time `json:"toDate"`
-	BatchID           int       `json:"batchId"`
-	FromAmount        float64   `json:"fromAmount"`
-	ToAmount          float64   `json:"toAmount"`
-	SourcePubkey      string    `json:"sourcePk"`
-	DestinationPubkey string    `json:"destinationPk"`
-	QueryOffset       uint      `json:"offset"`
-	QueryLimitRows    uint      `json:"limitRows"`
+	Currency          string                      `json:"currency"`
+	Filter            consts.FinanceHistoryFilter `json:"filters"` // by default: all transactions
+	ReferenceNumber   string                      `json:"referenceNumber"`
+	FromDate          time.Time                   `json:"fromDate"`
+	ToDate            time.Time                   `json:"toDate"`
+	BatchID           int                         `json:"batchId"`
+	FromAmount        float64                     `json:"fromAmount"`
+	ToAmount          float64                     `json:"toAmount"`
+	SourcePubkey      string                      `json:"sourcePk"`
+	DestinationPubkey string                      `json:"destinationPk"`
+	QueryOffset       uint                        `json:"offset"`
+	QueryLimitRows    uint                        `json:"limitRows"`
+}
+
+type FinanceHistoryIteratorTask struct {
+	// optional
+	Query    GetFinanceHistoryTask // QueryOffset is the start offset, QueryLimitRows is ignored
+	PageSize uint                  // by default: 100
+
+	// transactions are returned from newest to oldest,
+	// iteration stops at the first transaction created before this date
+	StopDate time.Time
 }
 
 type SendPaymentTask struct {
@@ -41,3 +51,22 @@ type GetChannelsTask struct {
 	ToDate       time.Time
 	SortBy       consts.SortChannelsBy
 }
+
+type ChannelHistoryIteratorTask struct {
+	// required
+	ChannelID string
+
+	// optional
+	Direction consts.HistoryDirection // by default: backward, from newest to oldest
+	PageSize  int                     // by default: 100
+
+	// cursor message ID, sent as `fromId`. the cursor message itself is not returned
+	// whether the API includes it into the page or not, so one more message is requested.
+	// by default: from the edge of history
+	FromID uint64
+
+	// iteration stops at the first message reaching the ID or the date,
+	// this message is not returned
+	StopID   uint64
+	StopDate time.Time
+}
@@ -0,0 +1,33 @@
+package structs
+
+import "time"
+
+// UNSRecord - uNS registered name
+type UNSRecord struct {
+	Name         string `json:"nick"`
+	Pubkey       string `json:"pk"` // can be empty for own names
+	IsPrimary    bool   `json:"isPrimary"`
+	ChannelID    string `json:"channelId"`  // linked channel, can be empty
+	RegisteredOn string `json:"registered"` // 2022-09-09T05:47:52.972Z
+	ValidTo      string `json:"valid"`      // 2023-09-09T05:47:52.972Z
+}
+
+// UNSTransfer - uNS name transfer request
+type UNSTransfer struct {
+	ID         string `json:"id"`
+	Name       string `json:"nick"`
+	PubkeyHash string `json:"hashedPk"` // sender for incoming, recipient for outgoing
+	CreatedOn  string `json:"created"`
+	Status     string `json:"status"`
+}
+
+type UNSRecordTask struct {
+	// required
+	Name string `json:"nick"`
+
+	// optional
+	ValidTo   time.Time `json:"valid"` // by default: uNS default TTL
+	IsPrimary bool      `json:"isPrimary"`
+	ChannelID string    `json:"channelId"` // channel to link with the name
+	MaxFee    float64   `json:"-"`         // registration only: fail when the name fee is higher
+}
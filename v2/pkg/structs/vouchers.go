@@ -0,0 +1,57 @@
+package structs
+
+import "time"
+
+// Voucher - voucher data
+type Voucher struct {
+	ID              string  `json:"voucherid"` // voucher code
+	Amount          float64 `json:"amount"`
+	Currency        string  `json:"currency"`
+	BatchID         int64   `json:"batchId"`
+	ReferenceNumber string  `json:"referenceNumber"` // returned on voucher creation
+	CreatedOn       string  `json:"created"`         // 2022-09-09T05:47:52.972Z
+}
+
+type GetVouchersTask struct {
+	// optional
+	Currency        string `json:"currency"` // example: "CRP", "UUSD"
+	BatchID         int64  `json:"batchId"`
+	ReferenceNumber string `json:"referenceNumber"`
+}
+
+// VoucherBatch - vouchers created by one request
+type VoucherBatch struct {
+	ReferenceNumber string    `json:"referenceNumber"`
+	Currency        string    `json:"currency"`
+	Vouchers        []Voucher `json:"vouchers"`
+}
+
+// Codes - get batch voucher codes
+func (b VoucherBatch) Codes() []string {
+	codes := make([]string, 0, len(b.Vouchers))
+	for _, v := range b.Vouchers {
+		codes = append(codes, v.ID)
+	}
+	return codes
+}
+
+// TotalAmount - get the sum of batch vouchers amounts
+func (b VoucherBatch) TotalAmount() float64 {
+	var total float64
+	for _, v := range b.Vouchers {
+		total += v.Amount
+	}
+	return total
+}
+
+type CreateVoucherBatchTask struct {
+	// required
+	Amount float64 `json:"amount"` // amount of each voucher
+
+	// optional
+	Count        int           `json:"count"`        // by default: 1
+	Currency     string        `json:"currency"`     // "CRP" or "UUSD". by default: "CRP"
+	Timeout      time.Duration `json:"timeout"`      // batch waiting timeout. by default: 30s
+	PollInterval time.Duration `json:"pollInterval"` // batch check interval. by default: 1s
+	CheckLimits  bool          `json:"checkLimits"`  // check finance limits before the creation
+}
@@ -0,0 +1,233 @@
+package websocket
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	defaultSubscriberBufferSize = 100
+	subscriberErrorsBufferSize  = 10
+)
+
+// EventFilter - subscriber events filter
+type EventFilter struct {
+	// optional
+	Types      []string // event types. by default: all
+	ChannelIDs []string // only channel events with these channel IDs. by default: all events
+	BufferSize int      // subscriber events channel size, events are dropped when it's full. by default: 100
+}
+
+func (f EventFilter) match(event WsEvent) bool {
+	if len(f.Types) > 0 && !contains(f.Types, event.Type) {
+		return false
+	}
+	if len(f.ChannelIDs) > 0 {
+		channelID, _ := event.Data["channelid"].(string)
+		return contains(f.ChannelIDs, channelID)
+	}
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+type subscriber struct {
+	ctx    context.Context
+	filter EventFilter
+
+	mu       sync.Mutex // guards channels closing
+	isClosed bool
+	events   chan WsEvent
+	errs     chan error
+}
+
+// Broker - fans out events of a single websocket connection to the subscribers.
+// connection is opened with the first subscriber and closed when the last one leaves
+type Broker struct {
+	url  string
+	task WsSubscribeTask
+
+	mu          sync.RWMutex
+	handler     Handler
+	subscribers map[*subscriber]struct{}
+	dropped     uint64
+}
+
+// NewBroker - create broker. connection settings are taken from the task,
+// its callbacks are replaced by the broker ones
+func NewBroker(URL string, task WsSubscribeTask) *Broker {
+	b := &Broker{
+		url:         URL,
+		subscribers: map[*subscriber]struct{}{},
+	}
+
+	task.OnConnected = func() {}
+	task.Callback = b.broadcast
+	task.ErrCallback = b.broadcastError
+	if task.Delivery == nil {
+		// keep events order
+		task.Delivery = &DeliveryPolicy{}
+	}
+	b.task = task
+	return b
+}
+
+// Subscribe - receive events matching the filter until ctx is done.
+// both channels are closed when ctx is done, the connection can't be opened
+// or it's lost & won't be restored. the next Subscribe opens a new connection.
+// a slow subscriber doesn't delay the rest: events that don't fit
+// its buffer are dropped and counted in DroppedEvents
+func (b *Broker) Subscribe(ctx context.Context, filter EventFilter) (<-chan WsEvent, <-chan error) {
+	bufferSize := filter.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultSubscriberBufferSize
+	}
+
+	s := &subscriber{
+		ctx:    ctx,
+		filter: filter,
+		events: make(chan WsEvent, bufferSize),
+		errs:   make(chan error, subscriberErrorsBufferSize),
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.handler == nil {
+		var h Handler
+		task := b.task
+		task.OnClosed = func(err error) {
+			b.onHandlerClosed(h)
+		}
+
+		h = NewWsHandler(b.url, task)
+		if err := h.Connect(); err != nil {
+			s.errs <- err
+			s.close()
+			return s.events, s.errs
+		}
+		b.handler = h
+	}
+
+	b.subscribers[s] = struct{}{}
+	go b.unsubscribeOnDone(s)
+	return s.events, s.errs
+}
+
+func (b *Broker) unsubscribeOnDone(s *subscriber) {
+	<-s.ctx.Done()
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.subscribers, s)
+	s.close()
+
+	if len(b.subscribers) == 0 && b.handler != nil {
+		b.handler.Close()
+		b.handler = nil
+	}
+}
+
+// DroppedEvents - number of events dropped because subscribers buffers were full
+func (b *Broker) DroppedEvents() uint64 {
+	return atomic.LoadUint64(&b.dropped)
+}
+
+// onHandlerClosed closes the subscribers of the lost connection,
+// the error is already passed to them by broadcastError
+func (b *Broker) onHandlerClosed(h Handler) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.handler != h {
+		// connection is already replaced
+		return
+	}
+
+	for s := range b.subscribers {
+		delete(b.subscribers, s)
+		s.close()
+	}
+	b.handler.Close()
+	b.handler = nil
+}
+
+func (s *subscriber) close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed {
+		return
+	}
+	s.isClosed = true
+	close(s.events)
+	close(s.errs)
+}
+
+// trySend doesn't block: false is returned when the buffer is full
+func (s *subscriber) trySend(event WsEvent) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed {
+		return true
+	}
+
+	select {
+	case s.events <- event:
+		return true
+	default:
+		return false
+	}
+}
+
+func (s *subscriber) trySendError(err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed {
+		return
+	}
+
+	select {
+	case s.errs <- err:
+	default:
+		// errors are not read by the subscriber
+	}
+}
+
+// getSubscribers returns the subscribers snapshot,
+// so sending doesn't hold the broker lock
+func (b *Broker) getSubscribers() []*subscriber {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	r := make([]*subscriber, 0, len(b.subscribers))
+	for s := range b.subscribers {
+		r = append(r, s)
+	}
+	return r
+}
+
+func (b *Broker) broadcast(event WsEvent) {
+	for _, s := range b.getSubscribers() {
+		if s.filter.match(event) && !s.trySend(event) {
+			atomic.AddUint64(&b.dropped, 1)
+		}
+	}
+}
+
+func (b *Broker) broadcastError(err error) {
+	for _, s := range b.getSubscribers() {
+		s.trySendError(err)
+	}
+}
@@ -0,0 +1,188 @@
+package websocket
+
+import (
+	"context"
+	"fmt"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+func receiveTestEvent(t *testing.T, events <-chan WsEvent) WsEvent {
+	select {
+	case e, isOpen := <-events:
+		require.True(t, isOpen)
+		return e
+	case <-time.After(testTimeout):
+		t.Fatal("event is not received")
+	}
+	return WsEvent{}
+}
+
+func TestBrokerSubscribe(t *testing.T) {
+	start := make(chan struct{})
+	closed := make(chan struct{})
+	server := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		defer close(closed)
+		<-start
+
+		for _, event := range []string{
+			`{"type": "newChannelMessage", "data": {"channelid": "first", "text": "1"}}`,
+			`{"type": "newChannelMessage", "data": {"channelid": "second", "text": "2"}}`,
+			`{"type": "newInstantMessage", "data": {"pk": "pubkey", "text": "3"}}`,
+		} {
+			require.NoError(t, websocket.Message.Send(ws, event))
+		}
+
+		var msg string
+		for websocket.Message.Receive(ws, &msg) == nil {
+		}
+	}))
+	defer server.Close()
+
+	b := NewBroker(getTestWsURL(server), WsSubscribeTask{DisablePing: true})
+	ctx, cancel := context.WithCancel(context.Background())
+
+	allEvents, _ := b.Subscribe(ctx, EventFilter{})
+	channelEvents, _ := b.Subscribe(ctx, EventFilter{
+		Types:      []string{EventChannelMessage},
+		ChannelIDs: []string{"second"},
+	})
+	close(start)
+
+	for _, text := range []string{"1", "2", "3"} {
+		assert.Equal(t, text, receiveTestEvent(t, allEvents).Data["text"])
+	}
+	assert.Equal(t, "2", receiveTestEvent(t, channelEvents).Data["text"])
+
+	// when all subscribers are done, connection is closed
+	cancel()
+	select {
+	case <-closed:
+	case <-time.After(testTimeout):
+		t.Fatal("connection is not closed")
+	}
+
+	for range allEvents {
+	}
+	for range channelEvents {
+	}
+}
+
+func TestBrokerSlowSubscriber(t *testing.T) {
+	const eventsCount = 20
+	start := make(chan struct{})
+	server := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		<-start
+		for i := 0; i < eventsCount; i++ {
+			event := fmt.Sprintf(`{"type": "test", "data": {"id": %d}}`, i)
+			require.NoError(t, websocket.Message.Send(ws, event))
+		}
+
+		var msg string
+		for websocket.Message.Receive(ws, &msg) == nil {
+		}
+	}))
+	defer server.Close()
+
+	b := NewBroker(getTestWsURL(server), WsSubscribeTask{DisablePing: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// slow subscriber doesn't read its events
+	slowCtx, slowCancel := context.WithCancel(ctx)
+	slowEvents, _ := b.Subscribe(slowCtx, EventFilter{BufferSize: 1})
+	events, _ := b.Subscribe(ctx, EventFilter{})
+	close(start)
+
+	for i := 0; i < eventsCount; i++ {
+		event := receiveTestEvent(t, events)
+		id, err := event.GetInt("id")
+		require.NoError(t, err)
+		assert.Equal(t, int64(i), id)
+	}
+	// only the first event fits the slow subscriber buffer
+	assert.Eventually(t, func() bool {
+		return b.DroppedEvents() == eventsCount-1
+	}, testTimeout, time.Millisecond)
+
+	// when slow subscriber leaves
+	slowCancel()
+	event := receiveTestEvent(t, slowEvents)
+	id, err := event.GetInt("id")
+	require.NoError(t, err)
+	assert.Equal(t, int64(0), id)
+	select {
+	case _, isOpen := <-slowEvents:
+		assert.False(t, isOpen)
+	case <-time.After(testTimeout):
+		t.Fatal("slow subscriber is not unsubscribed")
+	}
+}
+
+func TestBrokerConnectionLost(t *testing.T) {
+	var connections int32
+	server := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		atomic.AddInt32(&connections, 1)
+		// connection is closed right after the event
+		require.NoError(t, websocket.Message.Send(ws, `{"type": "test", "data": {}}`))
+	}))
+	defer server.Close()
+
+	b := NewBroker(getTestWsURL(server), WsSubscribeTask{DisablePing: true})
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, errs := b.Subscribe(ctx, EventFilter{})
+	assert.Equal(t, "test", receiveTestEvent(t, events).Type)
+
+	// when connection is lost without reconnect, channels are closed
+	select {
+	case err, isOpen := <-errs:
+		require.True(t, isOpen)
+		require.Error(t, err)
+	case <-time.After(testTimeout):
+		t.Fatal("error is not received")
+	}
+	for _, ch := range []<-chan WsEvent{events} {
+		select {
+		case _, isOpen := <-ch:
+			assert.False(t, isOpen)
+		case <-time.After(testTimeout):
+			t.Fatal("events channel is not closed")
+		}
+	}
+	_, isOpen := <-errs
+	assert.False(t, isOpen)
+
+	// next subscriber opens a new connection
+	events, _ = b.Subscribe(ctx, EventFilter{})
+	assert.Equal(t, "test", receiveTestEvent(t, events).Type)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&connections))
+}
+
+func TestBrokerSubscribeDialError(t *testing.T) {
+	b := NewBroker("ws://127.0.0.1:1/", WsSubscribeTask{DisablePing: true})
+
+	events, errs := b.Subscribe(context.Background(), EventFilter{})
+	require.Error(t, <-errs)
+
+	_, isOpen := <-events
+	assert.False(t, isOpen)
+}
+
+func TestEventFilterMatch(t *testing.T) {
+	event := WsEvent{Type: EventChannelMessage, Data: map[string]interface{}{
+		"channelid": "channelID",
+	}}
+
+	assert.True(t, EventFilter{}.match(event))
+	assert.True(t, EventFilter{ChannelIDs: []string{"channelID"}}.match(event))
+	assert.False(t, EventFilter{Types: []string{EventEmail}}.match(event))
+	assert.False(t, EventFilter{ChannelIDs: []string{"other"}}.match(WsEvent{Type: EventEmail}))
+}
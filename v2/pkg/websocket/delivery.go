@@ -0,0 +1,163 @@
+package websocket
+
+import (
+	"hash/fnv"
+	"sync"
+	"sync/atomic"
+)
+
+const defaultDeliveryQueueSize = 100
+
+// BackpressurePolicy - what to do with a new event when the worker queue is full
+type BackpressurePolicy int
+
+const (
+	BackpressureBlock      BackpressurePolicy = iota // wait for the queue, reading from the connection is paused
+	BackpressureDropOldest                           // drop the oldest queued event
+	BackpressureDropNewest                           // drop the new event
+)
+
+// DeliveryPolicy - bounded events delivery settings.
+// events with the same key are passed to the callback in the order of arrival
+type DeliveryPolicy struct {
+	// optional
+	Workers      int                     // by default: 1
+	QueueSize    int                     // queue size per worker. by default: 100
+	Backpressure BackpressurePolicy      // by default: block
+	GetKey       func(ws WsEvent) string // by default: GetEventKey
+}
+
+// GetEventKey - get channel ID or contact pubkey of the event, or its type for other events
+func GetEventKey(ws WsEvent) string {
+	for _, field := range []string{"channelid", "pk"} {
+		if key, isFound := ws.Data[field].(string); isFound && key != "" {
+			return key
+		}
+	}
+	return ws.Type
+}
+
+type deliverer struct {
+	policy   DeliveryPolicy
+	callback WsEventsCallback
+	queues   []chan WsEvent
+	stop     chan struct{}
+
+	startOnce sync.Once
+	pending   sync.WaitGroup // queued events
+	dropped   uint64
+}
+
+func newDeliverer(
+	policy DeliveryPolicy,
+	callback WsEventsCallback,
+	stop chan struct{},
+) *deliverer {
+	if policy.Workers <= 0 {
+		policy.Workers = 1
+	}
+	if policy.QueueSize <= 0 {
+		policy.QueueSize = defaultDeliveryQueueSize
+	}
+	if policy.GetKey == nil {
+		policy.GetKey = GetEventKey
+	}
+
+	d := &deliverer{
+		policy:   policy,
+		callback: callback,
+		queues:   make([]chan WsEvent, policy.Workers),
+		stop:     stop,
+	}
+	for i := range d.queues {
+		d.queues[i] = make(chan WsEvent, policy.QueueSize)
+	}
+	return d
+}
+
+// start runs the workers once, they are stopped with the handler
+func (d *deliverer) start() {
+	d.startOnce.Do(func() {
+		for _, queue := range d.queues {
+			go d.work(queue)
+		}
+	})
+}
+
+func (d *deliverer) work(queue chan WsEvent) {
+	for {
+		select {
+		case <-d.stop:
+			return
+		case event := <-queue:
+			d.callback(event)
+			d.pending.Done()
+		}
+	}
+}
+
+func (d *deliverer) getQueue(event WsEvent) chan WsEvent {
+	h := fnv.New32a()
+	h.Write([]byte(d.policy.GetKey(event)))
+	return d.queues[h.Sum32()%uint32(len(d.queues))]
+}
+
+func (d *deliverer) push(event WsEvent) {
+	queue := d.getQueue(event)
+	d.pending.Add(1)
+
+	switch d.policy.Backpressure {
+	case BackpressureDropNewest:
+		select {
+		case queue <- event:
+		default:
+			d.drop()
+		}
+
+	case BackpressureDropOldest:
+		for {
+			select {
+			case queue <- event:
+				return
+			default:
+			}
+
+			select {
+			case <-queue:
+				d.drop()
+			default:
+			}
+		}
+
+	default:
+		select {
+		case queue <- event:
+		case <-d.stop:
+			d.pending.Done()
+		}
+	}
+}
+
+func (d *deliverer) drop() {
+	atomic.AddUint64(&d.dropped, 1)
+	d.pending.Done()
+}
+
+// flush waits until the queued events are passed to the callback
+// or the handler is closed. events must not be pushed meanwhile
+func (d *deliverer) flush() {
+	done := make(chan struct{})
+	go func() {
+		d.pending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-d.stop:
+	}
+}
+
+func (d *deliverer) getDropped() uint64 {
+	return atomic.LoadUint64(&d.dropped)
+}
@@ -0,0 +1,118 @@
+package websocket
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestEvent(channelID string, n int) WsEvent {
+	return WsEvent{Type: EventChannelMessage, Data: map[string]interface{}{
+		"channelid": channelID,
+		"id":        float64(n),
+	}}
+}
+
+func TestDeliveryOrderPerKey(t *testing.T) {
+	var (
+		mu       sync.Mutex
+		received = map[string][]float64{}
+		wg       sync.WaitGroup
+	)
+	const eventsCount = 100
+	wg.Add(2 * eventsCount)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	d := newDeliverer(DeliveryPolicy{Workers: 4, QueueSize: 10}, func(e WsEvent) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		channelID := e.Data["channelid"].(string)
+		received[channelID] = append(received[channelID], e.Data["id"].(float64))
+		wg.Done()
+	}, stop)
+	d.start()
+
+	for i := 0; i < eventsCount; i++ {
+		d.push(newTestEvent("first", i))
+		d.push(newTestEvent("second", i))
+	}
+	wg.Wait()
+
+	for _, ids := range received {
+		require.Len(t, ids, eventsCount)
+		for i, id := range ids {
+			assert.Equal(t, float64(i), id)
+		}
+	}
+	assert.Zero(t, d.getDropped())
+}
+
+func TestDeliveryDropNewest(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	// workers are not started, so the queue is not read
+	d := newDeliverer(DeliveryPolicy{
+		QueueSize:    2,
+		Backpressure: BackpressureDropNewest,
+	}, func(e WsEvent) {}, stop)
+
+	for i := 0; i < 5; i++ {
+		d.push(newTestEvent("channel", i))
+	}
+
+	assert.Equal(t, uint64(3), d.getDropped())
+	assert.Equal(t, float64(0), (<-d.queues[0]).Data["id"])
+}
+
+func TestDeliveryDropOldest(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+
+	d := newDeliverer(DeliveryPolicy{
+		QueueSize:    2,
+		Backpressure: BackpressureDropOldest,
+	}, func(e WsEvent) {}, stop)
+
+	for i := 0; i < 5; i++ {
+		d.push(newTestEvent("channel", i))
+	}
+
+	assert.Equal(t, uint64(3), d.getDropped())
+	assert.Equal(t, float64(3), (<-d.queues[0]).Data["id"])
+	assert.Equal(t, float64(4), (<-d.queues[0]).Data["id"])
+}
+
+func TestDeliveryBlockStopped(t *testing.T) {
+	stop := make(chan struct{})
+	d := newDeliverer(DeliveryPolicy{QueueSize: 1}, func(e WsEvent) {}, stop)
+	d.push(newTestEvent("channel", 0))
+
+	// when the queue is full, push waits until the handler is closed
+	pushed := make(chan struct{})
+	go func() {
+		d.push(newTestEvent("channel", 1))
+		close(pushed)
+	}()
+
+	close(stop)
+	select {
+	case <-pushed:
+	case <-time.After(testTimeout):
+		t.Fatal("push is not interrupted")
+	}
+}
+
+func TestGetEventKey(t *testing.T) {
+	assert.Equal(t, "channelID", GetEventKey(newTestEvent("channelID", 1)))
+	assert.Equal(t, "pubkey", GetEventKey(WsEvent{
+		Type: EventInstantMessage,
+		Data: map[string]interface{}{"pk": "pubkey"},
+	}))
+	assert.Equal(t, EventTransfer, GetEventKey(WsEvent{Type: EventTransfer}))
+}
@@ -0,0 +1,159 @@
+package websocket
+
+import (
+	"sync"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+// notification types sent by the client
+const (
+	EventInstantMessage        = "newInstantMessage"
+	EventChannelMessage        = "newChannelMessage"
+	EventPrivateChannelMessage = "newPrivateChannelMessage"
+	EventContactStatus         = "contactStatusNotification"
+	EventAuthRequest           = "newAuthorization"
+	EventTransfer              = "newWalletTransaction"
+	EventInvoice               = "newInvoice"
+	EventUNSTransfer           = "newUNSTransfer"
+	EventEmail                 = "newEmail"
+	EventChannelJoin           = "newChannelJoin"
+)
+
+// Dispatcher - calls typed handlers by the event type. usage:
+//
+//	d := websocket.NewDispatcher().
+//		OnChannelMessage(func(m structs.WsChannelMessage) { ... }).
+//		OnTransfer(func(t structs.WsTransfer) { ... })
+//
+//	client.WsSubscribe(websocket.WsSubscribeTask{Callback: d.Handle, ...})
+type Dispatcher struct {
+	mu        sync.RWMutex
+	handlers  map[string][]WsEventsCallback
+	onUnknown WsEventsCallback
+	onError   WsErrorCallback
+}
+
+func NewDispatcher() *Dispatcher {
+	return &Dispatcher{
+		handlers: map[string][]WsEventsCallback{},
+	}
+}
+
+// Handle - pass the event to the registered handlers
+func (d *Dispatcher) Handle(event WsEvent) {
+	d.mu.RLock()
+	handlers := d.handlers[event.Type]
+	onUnknown := d.onUnknown
+	d.mu.RUnlock()
+
+	if len(handlers) == 0 {
+		if onUnknown != nil {
+			onUnknown(event)
+		}
+		return
+	}
+
+	for _, handler := range handlers {
+		handler(event)
+	}
+}
+
+// On - register raw event handler for the event type
+func (d *Dispatcher) On(eventType string, handler WsEventsCallback) *Dispatcher {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.handlers[eventType] = append(d.handlers[eventType], handler)
+	return d
+}
+
+// OnUnknown - register handler for the events without handlers
+func (d *Dispatcher) OnUnknown(handler WsEventsCallback) *Dispatcher {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.onUnknown = handler
+	return d
+}
+
+// OnError - register handler for the event data decoding errors
+func (d *Dispatcher) OnError(handler WsErrorCallback) *Dispatcher {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.onError = handler
+	return d
+}
+
+func (d *Dispatcher) handleError(err error) {
+	d.mu.RLock()
+	onError := d.onError
+	d.mu.RUnlock()
+
+	if onError != nil {
+		onError(err)
+	}
+}
+
+// onTyped registers handler receiving the event data decoded to T
+func onTyped[T any](d *Dispatcher, eventType string, handler func(T)) *Dispatcher {
+	return d.On(eventType, func(event WsEvent) {
+		var data T
+		if err := event.Decode(&data); err != nil {
+			d.handleError(err)
+			return
+		}
+		handler(data)
+	})
+}
+
+// OnInstantMessage - new contact message
+func (d *Dispatcher) OnInstantMessage(handler func(structs.InstantMessage)) *Dispatcher {
+	return onTyped(d, EventInstantMessage, handler)
+}
+
+// OnChannelMessage - new channel message
+func (d *Dispatcher) OnChannelMessage(handler func(structs.WsChannelMessage)) *Dispatcher {
+	return onTyped(d, EventChannelMessage, handler)
+}
+
+// OnPrivateChannelMessage - new private message from the channel contact
+func (d *Dispatcher) OnPrivateChannelMessage(handler func(structs.WsChannelMessage)) *Dispatcher {
+	return onTyped(d, EventPrivateChannelMessage, handler)
+}
+
+// OnContactStatus - contact status changed
+func (d *Dispatcher) OnContactStatus(handler func(structs.WsContactStatus)) *Dispatcher {
+	return onTyped(d, EventContactStatus, handler)
+}
+
+// OnAuthRequest - new authorization request
+func (d *Dispatcher) OnAuthRequest(handler func(structs.WsAuthRequest)) *Dispatcher {
+	return onTyped(d, EventAuthRequest, handler)
+}
+
+// OnTransfer - new wallet transfer
+func (d *Dispatcher) OnTransfer(handler func(structs.WsTransfer)) *Dispatcher {
+	return onTyped(d, EventTransfer, handler)
+}
+
+// OnInvoice - new invoice or invoice status changed
+func (d *Dispatcher) OnInvoice(handler func(structs.Invoice)) *Dispatcher {
+	return onTyped(d, EventInvoice, handler)
+}
+
+// OnUNSTransfer - new uNS name transfer request
+func (d *Dispatcher) OnUNSTransfer(handler func(structs.UNSTransfer)) *Dispatcher {
+	return onTyped(d, EventUNSTransfer, handler)
+}
+
+// OnEmail - new uMail message
+func (d *Dispatcher) OnEmail(handler func(structs.Email)) *Dispatcher {
+	return onTyped(d, EventEmail, handler)
+}
+
+// OnChannelJoin - contact joined the channel
+func (d *Dispatcher) OnChannelJoin(handler func(structs.WsChannelJoin)) *Dispatcher {
+	return onTyped(d, EventChannelJoin, handler)
+}
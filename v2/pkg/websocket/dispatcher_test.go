@@ -0,0 +1,49 @@
+package websocket
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
+func TestDispatcher(t *testing.T) {
+	var (
+		message  structs.WsChannelMessage
+		transfer structs.WsTransfer
+		unknown  []string
+		errs     []error
+	)
+
+	d := NewDispatcher().
+		OnChannelMessage(func(m structs.WsChannelMessage) { message = m }).
+		OnTransfer(func(tr structs.WsTransfer) { transfer = tr }).
+		OnUnknown(func(e WsEvent) { unknown = append(unknown, e.Type) }).
+		OnError(func(err error) { errs = append(errs, err) })
+
+	d.Handle(WsEvent{Type: EventChannelMessage, Data: map[string]interface{}{
+		"channelid": "channelID",
+		"text":      "hello",
+	}})
+	assert.Equal(t, "channelID", message.ChannelID)
+	assert.Equal(t, "hello", message.Text)
+
+	d.Handle(WsEvent{Type: EventTransfer, Data: map[string]interface{}{
+		"amount":     float64(5),
+		"isIncoming": true,
+	}})
+	assert.Equal(t, float64(5), transfer.Amount)
+	assert.True(t, transfer.IsIncoming)
+
+	// when there are no handlers for the event
+	d.Handle(WsEvent{Type: EventEmail})
+	assert.Equal(t, []string{EventEmail}, unknown)
+
+	// when event data can't be decoded
+	d.Handle(WsEvent{Type: EventTransfer, Data: map[string]interface{}{
+		"amount": "five",
+	}})
+	require.Len(t, errs, 1)
+}
@@ -1,86 +1,205 @@
 package websocket
 
 import (
-	"errors"
-	"reflect"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/consts"
 )
 
+// Get - get field value from ws event by path, nested fields are separated by dots:
+// "file.name", "files.0.name".
+// throw error when not found
+func (ws *WsEvent) Get(path string) (interface{}, error) {
+	var value interface{} = ws.Data
+	for _, key := range strings.Split(path, ".") {
+		switch node := value.(type) {
+		case map[string]interface{}:
+			v, isFound := node[key]
+			if !isFound {
+				return nil, fmt.Errorf("field `%s` not found", path)
+			}
+			value = v
+
+		case []interface{}:
+			i, err := strconv.Atoi(key)
+			if err != nil || i < 0 || i >= len(node) {
+				return nil, fmt.Errorf("field `%s` not found: invalid index %q", path, key)
+			}
+			value = node[i]
+
+		default:
+			return nil, fmt.Errorf("field `%s` not found: %q is not an object", path, key)
+		}
+	}
+	return value, nil
+}
+
+func newTypeError(path string, value interface{}, typeName string) error {
+	return fmt.Errorf("field `%s` type is `%T` not a %s", path, value, typeName)
+}
+
 // GetString - get string field from ws event.
 // throw error when not found or is not convertable to this type
-func (ws *WsEvent) GetString(field string) (string, error) {
-	errHandler := func(err error) (string, error) {
+func (ws *WsEvent) GetString(path string) (string, error) {
+	valRaw, err := ws.Get(path)
+	if err != nil {
 		return "", err
 	}
 
-	valRaw, isFound := ws.Data[field]
-	if !isFound {
-		return errHandler(errors.New("field `" + field + "` not found"))
-	}
-
 	val, isConvertable := valRaw.(string)
 	if !isConvertable {
-		return errHandler(errors.New("field `" + field + "` type is `" + reflect.ValueOf(valRaw).String() + "` not a string"))
+		return "", newTypeError(path, valRaw, "string")
 	}
-
 	return val, nil
 }
 
 // GetBool - get bool field from ws event.
 // throw error when not found or is not convertable to this type
-func (ws *WsEvent) GetBool(field string) (bool, error) {
-	errHandler := func(err error) (bool, error) {
+func (ws *WsEvent) GetBool(path string) (bool, error) {
+	valRaw, err := ws.Get(path)
+	if err != nil {
 		return false, err
 	}
 
-	valRaw, isFound := ws.Data[field]
-	if !isFound {
-		return errHandler(errors.New("field `" + field + "` not found"))
-	}
-
 	val, isConvertable := valRaw.(bool)
 	if !isConvertable {
-		return errHandler(errors.New("field `" + field + "` type is `" + reflect.ValueOf(valRaw).String() + "` not a bool"))
+		return false, newTypeError(path, valRaw, "bool")
 	}
-
 	return val, nil
 }
 
 // GetInt - get int64 field from ws event.
 // throw error when not found or is not convertable to this type
-func (ws *WsEvent) GetInt(field string) (int64, error) {
-	errHandler := func(err error) (int64, error) {
+func (ws *WsEvent) GetInt(path string) (int64, error) {
+	valRaw, err := ws.Get(path)
+	if err != nil {
 		return 0, err
 	}
 
-	valRaw, isFound := ws.Data[field]
-	if !isFound {
-		return errHandler(errors.New("field `" + field + "` not found"))
-	}
+	switch val := valRaw.(type) {
+	case json.Number:
+		r, err := val.Int64()
+		if err != nil {
+			return 0, fmt.Errorf("field `%s` is not an int64: %w", path, err)
+		}
+		return r, nil
 
-	val, isConvertable := valRaw.(int64)
-	if !isConvertable {
-		return errHandler(errors.New("field `" + field + "` type is `" + reflect.ValueOf(valRaw).String() + "` not a int64"))
-	}
+	case float64:
+		if val != math.Trunc(val) || val < math.MinInt64 || val >= math.MaxInt64 {
+			return 0, fmt.Errorf("field `%s` value %v is not an int64", path, val)
+		}
+		return int64(val), nil
 
-	return val, nil
+	case int64:
+		return val, nil
+
+	case int:
+		return int64(val), nil
+
+	default:
+		return 0, newTypeError(path, valRaw, "int64")
+	}
 }
 
-// GetFloat - get int64 field from ws event.
+// GetFloat - get float64 field from ws event.
 // throw error when not found or is not convertable to this type
-func (ws *WsEvent) GetFloat(field string) (float64, error) {
-	errHandler := func(err error) (float64, error) {
+func (ws *WsEvent) GetFloat(path string) (float64, error) {
+	valRaw, err := ws.Get(path)
+	if err != nil {
 		return 0, err
 	}
 
-	valRaw, isFound := ws.Data[field]
-	if !isFound {
-		return errHandler(errors.New("field `" + field + "` not found"))
+	switch val := valRaw.(type) {
+	case json.Number:
+		r, err := val.Float64()
+		if err != nil {
+			return 0, fmt.Errorf("field `%s` is not a float64: %w", path, err)
+		}
+		return r, nil
+
+	case float64:
+		return val, nil
+
+	case int64:
+		return float64(val), nil
+
+	case int:
+		return float64(val), nil
+
+	default:
+		return 0, newTypeError(path, valRaw, "float64")
 	}
+}
+
+// GetTime - get time field from ws event, like `dateTime`.
+// throw error when not found or can't be parsed
+func (ws *WsEvent) GetTime(path string) (time.Time, error) {
+	val, err := ws.GetString(path)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	for _, layout := range consts.DateTimeLayouts {
+		if t, err := time.Parse(layout, val); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("field `%s` value %q is not a time", path, val)
+}
 
-	val, isConvertable := valRaw.(float64)
+// GetObject - get nested object field from ws event.
+// throw error when not found or is not an object
+func (ws *WsEvent) GetObject(path string) (map[string]interface{}, error) {
+	valRaw, err := ws.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	val, isConvertable := valRaw.(map[string]interface{})
 	if !isConvertable {
-		return errHandler(errors.New("field `" + field + "` type is `" + reflect.ValueOf(valRaw).String() + "` not a float64"))
+		return nil, newTypeError(path, valRaw, "object")
 	}
+	return val, nil
+}
 
+// GetArray - get array field from ws event.
+// throw error when not found or is not an array
+func (ws *WsEvent) GetArray(path string) ([]interface{}, error) {
+	valRaw, err := ws.Get(path)
+	if err != nil {
+		return nil, err
+	}
+
+	val, isConvertable := valRaw.([]interface{})
+	if !isConvertable {
+		return nil, newTypeError(path, valRaw, "array")
+	}
 	return val, nil
 }
+
+// Decode - convert the event data to the struct
+func (ws *WsEvent) Decode(into interface{}) error {
+	eventBytes, err := json.Marshal(ws.Data)
+	if err != nil {
+		return fmt.Errorf("failed to encode %q event data: %w", ws.Type, err)
+	}
+
+	if err := decodeJSON(eventBytes, into); err != nil {
+		return fmt.Errorf("failed to decode %q event data: %w", ws.Type, err)
+	}
+	return nil
+}
+
+// decodeJSON decodes numbers as json.Number to avoid precision loss
+// on large message IDs
+func decodeJSON(data []byte, into interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	return decoder.Decode(into)
+}
@@ -0,0 +1,123 @@
+package websocket
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func getTestEvent(t *testing.T) WsEvent {
+	event, err := newEvent([]byte(`{"type": "newChannelMessage", "data": {
+		"id": 9007199254740993,
+		"amount": 1.5,
+		"count": 3.0,
+		"text": "hello",
+		"isIncoming": true,
+		"dateTime": "2022-09-09T05:47:52.972Z",
+		"file": {"name": "image.png", "size": 2048},
+		"files": [{"name": "first.txt"}, {"name": "second.txt"}]
+	}}`))
+	require.NoError(t, err)
+	return event
+}
+
+func TestWsEventGetInt(t *testing.T) {
+	event := getTestEvent(t)
+
+	// large IDs are not rounded
+	id, err := event.GetInt("id")
+	require.NoError(t, err)
+	assert.Equal(t, int64(9007199254740993), id)
+
+	size, err := event.GetInt("file.size")
+	require.NoError(t, err)
+	assert.Equal(t, int64(2048), size)
+
+	// when number is not integer
+	_, err = event.GetInt("amount")
+	require.Error(t, err)
+
+	// when value is decoded as float64
+	event.Data["count"] = float64(3)
+	count, err := event.GetInt("count")
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), count)
+
+	event.Data["count"] = 3.5
+	_, err = event.GetInt("count")
+	require.Error(t, err)
+
+	// when field is not a number
+	_, err = event.GetInt("text")
+	require.Error(t, err)
+}
+
+func TestWsEventGetters(t *testing.T) {
+	event := getTestEvent(t)
+
+	amount, err := event.GetFloat("amount")
+	require.NoError(t, err)
+	assert.Equal(t, 1.5, amount)
+
+	text, err := event.GetString("text")
+	require.NoError(t, err)
+	assert.Equal(t, "hello", text)
+
+	isIncoming, err := event.GetBool("isIncoming")
+	require.NoError(t, err)
+	assert.True(t, isIncoming)
+
+	dateTime, err := event.GetTime("dateTime")
+	require.NoError(t, err)
+	assert.Equal(t, time.Date(2022, 9, 9, 5, 47, 52, 972000000, time.UTC), dateTime)
+
+	_, err = event.GetTime("text")
+	require.Error(t, err)
+
+	file, err := event.GetObject("file")
+	require.NoError(t, err)
+	assert.Equal(t, "image.png", file["name"])
+
+	files, err := event.GetArray("files")
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+}
+
+func TestWsEventGetPath(t *testing.T) {
+	event := getTestEvent(t)
+
+	name, err := event.GetString("file.name")
+	require.NoError(t, err)
+	assert.Equal(t, "image.png", name)
+
+	name, err = event.GetString("files.1.name")
+	require.NoError(t, err)
+	assert.Equal(t, "second.txt", name)
+
+	// when field is not found
+	for _, path := range []string{"unknown", "file.unknown", "files.2.name", "text.name"} {
+		_, err = event.Get(path)
+		require.Error(t, err, path)
+	}
+}
+
+func TestWsEventDecode(t *testing.T) {
+	event := getTestEvent(t)
+
+	data := struct {
+		ID   int64 `json:"id"`
+		File struct {
+			Name string `json:"name"`
+		} `json:"file"`
+	}{}
+	require.NoError(t, event.Decode(&data))
+	assert.Equal(t, int64(9007199254740993), data.ID)
+	assert.Equal(t, "image.png", data.File.Name)
+
+	// when data doesn't match the struct
+	require.Error(t, event.Decode(&struct {
+		Text int `json:"text"`
+	}{}))
+}
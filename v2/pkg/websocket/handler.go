@@ -1,69 +1,260 @@
 package websocket
 
 import (
-	"encoding/json"
 	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
 
-	"github.com/Sagleft/evtwebsocket"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	defaultReconnectBaseDelay = time.Second
+	defaultReconnectMaxDelay  = time.Minute
+
+	pingInterval = 5 * time.Second
+	pingMessage  = "PING"
+	wsOrigin     = "http://localhost/"
+)
+
+var (
+	// ErrReconnectFailed - all reconnect attempts failed, passed to ErrCallback
+	ErrReconnectFailed = errors.New("failed to reconnect to websocket")
+
+	errHandlerClosed = errors.New("websocket handler is closed")
 )
 
 type wsHandler struct {
-	url  string
-	conn evtwebsocket.Conn
-	task WsSubscribeTask
+	url      string
+	task     WsSubscribeTask
+	delivery *deliverer // nil when every event is passed to the callback in a new goroutine
+
+	mu       sync.Mutex
+	conn     *websocket.Conn
+	isClosed bool
+	stop     chan struct{} // closed by Close to interrupt reconnect & delivery
 }
 
 func NewWsHandler(URL string, task WsSubscribeTask) Handler {
 	h := &wsHandler{
 		url:  URL,
-		conn: evtwebsocket.Conn{},
 		task: task,
+		stop: make(chan struct{}),
 	}
-
-	h.conn.OnConnected = h.onConnected
-	h.conn.OnMessage = h.onMessage
-	h.conn.OnError = h.onError
-
-	if !task.DisablePing {
-		h.conn.PingIntervalSecs = 5     // ping interval in seconds
-		h.conn.PingMsg = []byte("PING") // ping message to send
+	if task.Delivery != nil {
+		h.delivery = newDeliverer(*task.Delivery, task.Callback, h.stop)
 	}
 	return h
 }
 
 func (h *wsHandler) Close() error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.isClosed {
+		return nil
+	}
+	h.isClosed = true
+	close(h.stop)
+
+	if h.conn == nil {
+		return nil
+	}
 	return h.conn.Close()
 }
 
 func (h *wsHandler) Connect() error {
-	return h.conn.Dial(h.url, "")
+	return h.dial()
+}
+
+// DroppedEvents - number of events dropped by the delivery backpressure policy
+func (h *wsHandler) DroppedEvents() uint64 {
+	if h.delivery == nil {
+		return 0
+	}
+	return h.delivery.getDropped()
+}
+
+func (h *wsHandler) dial() error {
+	conn, err := websocket.Dial(h.url, "", wsOrigin)
+	if err != nil {
+		return err
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.isClosed {
+		// handler was closed while dialing
+		conn.Close()
+		return errHandlerClosed
+	}
+	h.conn = conn
+
+	if h.delivery != nil {
+		h.delivery.start()
+	}
+
+	connDone := make(chan struct{})
+	go h.task.OnConnected()
+	go h.read(conn, connDone)
+	if !h.task.DisablePing {
+		go h.ping(conn, connDone)
+	}
+	return nil
+}
+
+// read receives messages one by one, so the delivery keeps their order
+func (h *wsHandler) read(conn *websocket.Conn, connDone chan struct{}) {
+	defer close(connDone)
+
+	for {
+		var msg []byte
+		if err := websocket.Message.Receive(conn, &msg); err != nil {
+			conn.Close()
+			h.onError(conn, err)
+			return
+		}
+		h.onMessage(msg)
+	}
+}
+
+func (h *wsHandler) ping(conn *websocket.Conn, connDone chan struct{}) {
+	ticker := time.NewTicker(pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-connDone:
+			return
+		case <-ticker.C:
+			if err := websocket.Message.Send(conn, pingMessage); err != nil {
+				return
+			}
+		}
+	}
 }
 
 func newEvent(jsonRaw []byte) (WsEvent, error) {
 	event := WsEvent{}
-	err := json.Unmarshal(jsonRaw, &event)
+	err := decodeJSON(jsonRaw, &event)
 	if err != nil {
 		return event, errors.New("failed to decode event json: " + err.Error())
 	}
 	return event, nil
 }
 
-// Fires when the connection is established
-func (h *wsHandler) onConnected(w *evtwebsocket.Conn) {
-	h.task.OnConnected()
-}
-
 // Fires when a new message arrives from the server
-func (h *wsHandler) onMessage(msg []byte, w *evtwebsocket.Conn) {
+func (h *wsHandler) onMessage(msg []byte) {
 	event, err := newEvent(msg)
-	if err == nil {
-		go h.task.Callback(event)
-	} else {
+	if err != nil {
 		go h.task.ErrCallback(err)
+		return
+	}
+
+	if h.delivery != nil {
+		h.delivery.push(event)
+	} else {
+		go h.task.Callback(event)
 	}
 }
 
 // Fires when an error occurs and connection is closed
-func (h *wsHandler) onError(err error) {
+func (h *wsHandler) onError(conn *websocket.Conn, err error) {
+	h.mu.Lock()
+	isActual := !h.isClosed && h.conn == conn
+	if isActual {
+		h.conn = nil
+	}
+	h.mu.Unlock()
+
+	if !isActual {
+		// connection is closed by the handler
+		return
+	}
+	h.task.ErrCallback(err)
+
+	policy := h.task.Reconnect
+	if policy == nil {
+		h.onClosed(err)
+		return
+	}
+
+	if policy.OnDisconnected != nil {
+		policy.OnDisconnected(err)
+	}
+	go h.reconnect(*policy)
+}
+
+func (h *wsHandler) reconnect(policy ReconnectPolicy) {
+	var err error
+	for attempt := 1; policy.MaxAttempts <= 0 || attempt <= policy.MaxAttempts; attempt++ {
+		select {
+		case <-h.stop:
+			return
+		case <-time.After(policy.getDelay(attempt)):
+		}
+
+		err = h.redial(policy)
+		if errors.Is(err, errHandlerClosed) {
+			return
+		}
+		if err == nil {
+			if policy.OnReconnected != nil {
+				policy.OnReconnected()
+			}
+			return
+		}
+	}
+
+	err = fmt.Errorf("%w: %v", ErrReconnectFailed, err)
 	h.task.ErrCallback(err)
+	h.onClosed(err)
+}
+
+// onClosed fires when the connection is lost & won't be restored,
+// after the received events are delivered
+func (h *wsHandler) onClosed(err error) {
+	if h.task.OnClosed == nil {
+		return
+	}
+	if h.delivery != nil {
+		h.delivery.flush()
+	}
+	h.task.OnClosed(err)
+}
+
+func (h *wsHandler) redial(policy ReconnectPolicy) error {
+	if policy.BeforeDial != nil {
+		if err := policy.BeforeDial(); err != nil {
+			return err
+		}
+	}
+	return h.dial()
+}
+
+// getDelay returns exponential backoff delay with jitter before the attempt
+func (p ReconnectPolicy) getDelay(attempt int) time.Duration {
+	baseDelay := p.BaseDelay
+	if baseDelay <= 0 {
+		baseDelay = defaultReconnectBaseDelay
+	}
+	maxDelay := p.MaxDelay
+	if maxDelay <= 0 {
+		maxDelay = defaultReconnectMaxDelay
+	}
+
+	delay := baseDelay
+	for i := 1; i < attempt && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	if delay > maxDelay {
+		delay = maxDelay
+	}
+
+	// random value in [delay/2, delay]
+	half := delay / 2
+	return half + time.Duration(rand.Int63n(int64(delay-half)+1))
 }
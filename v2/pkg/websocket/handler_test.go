@@ -0,0 +1,157 @@
+package websocket
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/websocket"
+)
+
+const testTimeout = 5 * time.Second
+
+// newTestServer sends the event to every connection.
+// the first connection is dropped right after that
+func newTestServer(t *testing.T) (*httptest.Server, *int32) {
+	var connections int32
+	server := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		n := atomic.AddInt32(&connections, 1)
+		require.NoError(t, websocket.Message.Send(ws, `{"type": "test", "data": {}}`))
+		if n == 1 {
+			return
+		}
+
+		var msg string
+		for websocket.Message.Receive(ws, &msg) == nil {
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &connections
+}
+
+func getTestWsURL(server *httptest.Server) string {
+	return "ws" + strings.TrimPrefix(server.URL, "http")
+}
+
+func TestWsHandlerReconnect(t *testing.T) {
+	server, connections := newTestServer(t)
+
+	events := make(chan WsEvent, 2)
+	reconnected := make(chan struct{})
+	var disconnects int32
+	h := NewWsHandler(getTestWsURL(server), WsSubscribeTask{
+		OnConnected: func() {},
+		Callback:    func(e WsEvent) { events <- e },
+		ErrCallback: func(err error) {},
+		DisablePing: true,
+		Reconnect: &ReconnectPolicy{
+			BaseDelay:      time.Millisecond,
+			OnDisconnected: func(err error) { atomic.AddInt32(&disconnects, 1) },
+			OnReconnected:  func() { close(reconnected) },
+		},
+	})
+	require.NoError(t, h.Connect())
+	defer h.Close()
+
+	select {
+	case <-reconnected:
+	case <-time.After(testTimeout):
+		t.Fatal("handler is not reconnected")
+	}
+
+	for i := 0; i < 2; i++ {
+		select {
+		case e := <-events:
+			assert.Equal(t, "test", e.Type)
+		case <-time.After(testTimeout):
+			t.Fatal("event is not received")
+		}
+	}
+	assert.Equal(t, int32(2), atomic.LoadInt32(connections))
+	assert.Equal(t, int32(1), atomic.LoadInt32(&disconnects))
+}
+
+func TestWsHandlerReconnectFailed(t *testing.T) {
+	server, _ := newTestServer(t)
+
+	errs := make(chan error, 10)
+	h := NewWsHandler(getTestWsURL(server), WsSubscribeTask{
+		OnConnected: func() {},
+		Callback:    func(e WsEvent) {},
+		ErrCallback: func(err error) { errs <- err },
+		DisablePing: true,
+		Reconnect: &ReconnectPolicy{
+			MaxAttempts: 2,
+			BaseDelay:   time.Millisecond,
+			BeforeDial:  func() error { return assert.AnError },
+		},
+	})
+	require.NoError(t, h.Connect())
+	defer h.Close()
+
+	timeout := time.After(testTimeout)
+	for {
+		select {
+		case err := <-errs:
+			if err != nil && strings.Contains(err.Error(), ErrReconnectFailed.Error()) {
+				require.ErrorIs(t, err, ErrReconnectFailed)
+				return
+			}
+		case <-timeout:
+			t.Fatal("reconnect error is not received")
+		}
+	}
+}
+
+func TestReconnectPolicyGetDelay(t *testing.T) {
+	p := ReconnectPolicy{BaseDelay: time.Second, MaxDelay: 4 * time.Second}
+
+	assert.LessOrEqual(t, p.getDelay(1), time.Second)
+	assert.GreaterOrEqual(t, p.getDelay(2), time.Second)
+	assert.LessOrEqual(t, p.getDelay(10), 4*time.Second)
+}
+
+func TestWsHandlerOrderedDelivery(t *testing.T) {
+	const eventsCount = 50
+	server := httptest.NewServer(websocket.Handler(func(ws *websocket.Conn) {
+		for i := 0; i < eventsCount; i++ {
+			event := fmt.Sprintf(`{"type": "test", "data": {"id": %d}}`, i)
+			require.NoError(t, websocket.Message.Send(ws, event))
+		}
+
+		var msg string
+		for websocket.Message.Receive(ws, &msg) == nil {
+		}
+	}))
+	defer server.Close()
+
+	events := make(chan WsEvent, eventsCount)
+	h := NewWsHandler(getTestWsURL(server), WsSubscribeTask{
+		OnConnected: func() {},
+		Callback:    func(e WsEvent) { events <- e },
+		ErrCallback: func(err error) {},
+		DisablePing: true,
+		Delivery:    &DeliveryPolicy{Workers: 4},
+	})
+	require.NoError(t, h.Connect())
+	defer h.Close()
+
+	for i := 0; i < eventsCount; i++ {
+		select {
+		case e := <-events:
+			id, err := e.GetInt("id")
+			require.NoError(t, err)
+			assert.Equal(t, int64(i), id)
+		case <-time.After(testTimeout):
+			t.Fatal("event is not received")
+		}
+	}
+	counter, isCounter := h.(DropCounter)
+	require.True(t, isCounter)
+	assert.Zero(t, counter.DroppedEvents())
+}
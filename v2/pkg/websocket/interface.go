@@ -1,9 +1,20 @@
 package websocket
 
 type Handler interface {
-	// NOTE: it's blocking method
+	// Connect - dial websocket, events are received in the background
 	Connect() error
 
 	// Close connection
 	Close() error
 }
+
+// DropCounter - optional Handler extension, implemented by the handler
+// returned from NewWsHandler:
+//
+//	if counter, ok := handler.(DropCounter); ok {
+//		dropped := counter.DroppedEvents()
+//	}
+type DropCounter interface {
+	// DroppedEvents - number of events dropped by the delivery backpressure policy
+	DroppedEvents() uint64
+}
@@ -1,5 +1,11 @@
 package websocket
 
+import (
+	"time"
+
+	"github.com/Sagleft/utopialib-go/v2/pkg/structs"
+)
+
 // WsEvent - websocket event
 type WsEvent struct {
 	Type string                 `json:"type"`
@@ -12,10 +18,39 @@ type WsErrorCallback func(err error)
 
 type WsSubscribeTask struct {
 	// required
-	OnConnected func()           // required
+	OnConnected func()           // required. called after every successful dial
 	Callback    WsEventsCallback // required
 	ErrCallback WsErrorCallback  // required
 
 	// optional
 	DisablePing bool
+	Reconnect   *ReconnectPolicy // reconnect when the connection is lost. by default: disabled
+
+	// bounded & ordered events delivery.
+	// by default: every event is passed to the Callback in a new goroutine
+	Delivery *DeliveryPolicy
+
+	// called after the ErrCallback when the connection is lost for good:
+	// reconnect is disabled or all reconnect attempts failed.
+	// events received before are already delivered when Delivery is set
+	OnClosed func(err error)
+}
+
+// ReconnectPolicy - websocket reconnect settings
+type ReconnectPolicy struct {
+	// optional
+	MaxAttempts int           // by default: 0 - unlimited
+	BaseDelay   time.Duration // delay before the first attempt, doubled on each next one. by default: 1s
+	MaxDelay    time.Duration // by default: 1m
+
+	// websocket state restored when notifications were disabled by the client restart.
+	// by default: not restored
+	RestoreState *structs.SetWsStateTask
+
+	OnDisconnected func(err error) // connection is lost, reconnect is started
+	OnReconnected  func()          // connection is restored
+
+	// called before each dial attempt, the attempt fails when it returns an error.
+	// it is set by the client when RestoreState is used
+	BeforeDial func() error
 }